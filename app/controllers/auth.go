@@ -0,0 +1,121 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/takuchi17/term-keeper/api"
+	"github.com/takuchi17/term-keeper/app/models"
+	"github.com/takuchi17/term-keeper/middleware"
+	"github.com/takuchi17/term-keeper/pkg/jwt"
+	"github.com/takuchi17/term-keeper/pkg/util"
+)
+
+type AuthHandler struct {
+	DB            models.SQLExecutor
+	SessionCookie SessionCookieOptions
+}
+
+func (h *AuthHandler) RefreshToken(ctx context.Context, request api.RefreshTokenRequestObject) (api.RefreshTokenResponseObject, error) {
+	// 本文になければ Cookie のリフレッシュトークンを使い、新しいトークンも Cookie で返す
+	var plainToken string
+	cookieMode := false
+	if request.Body != nil && request.Body.RefreshToken != nil {
+		plainToken = *request.Body.RefreshToken
+	} else if request.Params.TkRefresh != nil {
+		plainToken = *request.Params.TkRefresh
+		cookieMode = true
+	}
+	if plainToken == "" {
+		return api.RefreshToken400JSONResponse{Message: "refresh_token is required"}, nil
+	}
+	if cookieMode && !middleware.ValidCSRFToken(util.Deref(request.Params.XCSRFToken), util.Deref(request.Params.TkCsrf)) {
+		slog.Warn("Invalid CSRF token on refresh")
+		return api.RefreshToken403JSONResponse{Message: "Invalid CSRF token"}, nil
+	}
+
+	refreshToken, userId, err := models.RotateRefreshToken(h.DB, plainToken)
+	if errors.Is(err, models.ErrInvalidRefreshToken) || errors.Is(err, models.ErrRefreshTokenReused) {
+		slog.Warn("Failed to rotate refresh token", "err", err)
+		return api.RefreshToken401JSONResponse{Message: "Invalid refresh token"}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+
+	user, err := models.GetUserById(h.DB, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by id: %w", err)
+	}
+
+	token, err := jwt.GenerateToken(user.ID, user.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	expiresIn := int(jwt.AccessTokenTTL.Seconds())
+	if !cookieMode {
+		return api.RefreshToken200JSONResponse{Token: &token, RefreshToken: &refreshToken, ExpiresIn: &expiresIn}, nil
+	}
+
+	// 同時に送られたリクエストが失敗しないように CSRF トークンは変えない
+	csrfToken := *request.Params.TkCsrf
+	return refreshTokenWithCookies{
+		RefreshToken200JSONResponse: api.RefreshToken200JSONResponse{ExpiresIn: &expiresIn, CsrfToken: &csrfToken},
+		cookies:                     h.SessionCookie.sessionCookies(token, refreshToken, csrfToken),
+	}, nil
+}
+
+func (h *AuthHandler) LogoutUser(ctx context.Context, request api.LogoutUserRequestObject) (api.LogoutUserResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.LogoutUser401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	jti, ok := middleware.GetTokenID(ctx)
+	if !ok {
+		slog.Warn("Failed to get token ID from context")
+		return api.LogoutUser401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	expiresAt, ok := middleware.GetTokenExpiresAt(ctx)
+	if !ok {
+		slog.Warn("Failed to get token expiration from context")
+		return api.LogoutUser401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	if err := models.RevokeAccessToken(h.DB, jti, expiresAt); err != nil {
+		return nil, fmt.Errorf("failed to revoke access token: %w", err)
+	}
+
+	// リフレッシュトークンは任意。渡されたらそのログインごと無効にする
+	var plainToken string
+	if request.Body != nil && request.Body.RefreshToken != nil {
+		plainToken = *request.Body.RefreshToken
+	} else {
+		plainToken = util.Deref(request.Params.TkRefresh)
+	}
+	if plainToken != "" {
+		if err := models.RevokeRefreshTokenFamily(h.DB, models.UserId(userId), plainToken); err != nil {
+			return nil, fmt.Errorf("failed to revoke refresh token: %w", err)
+		}
+	}
+
+	return logoutUserWithCookies{cookies: h.SessionCookie.clearCookies()}, nil
+}
+
+func (h *AuthHandler) LogoutAllSessions(ctx context.Context, request api.LogoutAllSessionsRequestObject) (api.LogoutAllSessionsResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.LogoutAllSessions401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	if err := models.RevokeAllTokens(h.DB, models.UserId(userId), time.Now()); err != nil {
+		return nil, fmt.Errorf("failed to revoke all tokens: %w", err)
+	}
+
+	return logoutAllSessionsWithCookies{cookies: h.SessionCookie.clearCookies()}, nil
+}
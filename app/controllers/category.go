@@ -0,0 +1,174 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/takuchi17/term-keeper/api"
+	"github.com/takuchi17/term-keeper/app/models"
+	"github.com/takuchi17/term-keeper/middleware"
+
+	"github.com/takuchi17/term-keeper/pkg/util"
+)
+
+type CategoryHandler struct {
+	DB models.SQLExecutor
+}
+
+func (h *CategoryHandler) GetCategories(ctx context.Context, request api.GetCategoriesRequestObject) (api.GetCategoriesResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.GetCategories401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	categories, err := models.GetCategoriesByUserId(h.DB, models.CategoryUserId(userId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+
+	response := make([]api.CategoryResponse, len(categories))
+	for i, category := range categories {
+		response[i] = toCategoryResponse(category)
+	}
+
+	return api.GetCategories200JSONResponse(response), nil
+}
+
+func (h *CategoryHandler) CreateCategory(ctx context.Context, request api.CreateCategoryRequestObject) (api.CreateCategoryResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.CreateCategory401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	var hexColorCode models.CategoryHexColorCode
+	if request.Body.HexColorCode != nil {
+		hexColorCode = models.CategoryHexColorCode(*request.Body.HexColorCode)
+	}
+
+	createdCategory, err := models.CreateCategory(
+		h.DB,
+		models.CategoryUserId(userId),
+		models.CategoryName(request.Body.Name),
+		hexColorCode,
+	)
+	switch {
+	case errors.Is(err, models.ErrDuplicateCategoryName):
+		slog.Warn("Duplicate category name", "err", err)
+		return api.CreateCategory409JSONResponse{Message: err.Error()}, nil
+	case errors.Is(err, models.ErrCategoryNameRequired), errors.Is(err, models.ErrInvalidHexColorCode):
+		slog.Warn("Invalid category", "err", err)
+		return api.CreateCategory400JSONResponse{Message: err.Error()}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to create category: %w", err)
+	}
+
+	return api.CreateCategory201JSONResponse(toCategoryResponse(createdCategory)), nil
+}
+
+func (h *CategoryHandler) UpdateCategory(ctx context.Context, request api.UpdateCategoryRequestObject) (api.UpdateCategoryResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.UpdateCategory401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	categoryId := models.CategoryId(request.Id)
+	if request.Body.Id != string(categoryId) {
+		slog.Warn("Category ID mismatch between path and body", "path", categoryId, "body", request.Body.Id)
+		return api.UpdateCategory400JSONResponse{Message: "Category ID in path and body do not match"}, nil
+	}
+
+	category, err := h.getOwnCategory(categoryId, models.CategoryUserId(userId))
+	switch {
+	case errors.Is(err, errNotFound):
+		return api.UpdateCategory404JSONResponse{Message: "Category not found"}, nil
+	case errors.Is(err, errForbidden):
+		return api.UpdateCategory403JSONResponse{Message: "Forbidden"}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	category.Name = models.CategoryName(request.Body.Name)
+	if request.Body.HexColorCode != nil {
+		category.HexColorCode = models.CategoryHexColorCode(*request.Body.HexColorCode)
+	}
+	category.UpdatedAt = util.Ptr(time.Now())
+
+	updatedCategory, err := category.Update(h.DB)
+	switch {
+	case errors.Is(err, models.ErrDuplicateCategoryName):
+		slog.Warn("Duplicate category name", "err", err)
+		return api.UpdateCategory409JSONResponse{Message: err.Error()}, nil
+	case errors.Is(err, models.ErrCategoryNameRequired), errors.Is(err, models.ErrInvalidHexColorCode):
+		slog.Warn("Invalid category", "err", err)
+		return api.UpdateCategory400JSONResponse{Message: err.Error()}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+
+	return api.UpdateCategory200JSONResponse(toCategoryResponse(updatedCategory)), nil
+}
+
+func (h *CategoryHandler) DeleteCategory(ctx context.Context, request api.DeleteCategoryRequestObject) (api.DeleteCategoryResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.DeleteCategory401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	category, err := h.getOwnCategory(models.CategoryId(request.Id), models.CategoryUserId(userId))
+	switch {
+	case errors.Is(err, errNotFound):
+		return api.DeleteCategory404JSONResponse{Message: "Category not found"}, nil
+	case errors.Is(err, errForbidden):
+		return api.DeleteCategory403JSONResponse{Message: "Forbidden"}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	if err := category.Delete(h.DB); err != nil {
+		return nil, fmt.Errorf("failed to delete category: %w", err)
+	}
+
+	return api.DeleteCategory204Response{}, nil
+}
+
+// getOwnCategory loads the category and returns errNotFound or errForbidden
+// when it does not exist or belongs to another user.
+func (h *CategoryHandler) getOwnCategory(categoryId models.CategoryId, userId models.CategoryUserId) (*models.Category, error) {
+	category, err := models.GetCategoryById(h.DB, categoryId)
+	if errors.Is(err, sql.ErrNoRows) {
+		slog.Warn("Category not found", "categoryId", categoryId)
+		return nil, errNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	if category.FKUserId != userId {
+		slog.Warn("Category belongs to another user", "categoryId", categoryId, "userId", userId)
+		return nil, errForbidden
+	}
+
+	return category, nil
+}
+
+func toCategoryResponse(category *models.Category) api.CategoryResponse {
+	response := api.CategoryResponse{
+		Id:        util.Ptr(string(category.ID)),
+		Name:      util.Ptr(string(category.Name)),
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}
+	// 色が未設定のときは返さない
+	if category.HexColorCode != "" {
+		response.HexColorCode = util.Ptr(string(category.HexColorCode))
+	}
+	return response
+}
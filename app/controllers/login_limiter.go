@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/takuchi17/term-keeper/pkg/ratelimit"
+)
+
+// アカウントごとの失敗は少なめに許し、同じ IP から複数アカウントを試す攻撃は IP ごとに止める
+var (
+	accountLoginPolicy = ratelimit.Policy{
+		FreeAttempts: 5,
+		BaseDelay:    30 * time.Second,
+		MaxDelay:     15 * time.Minute,
+		ResetAfter:   time.Hour,
+	}
+	ipLoginPolicy = ratelimit.Policy{
+		FreeAttempts: 20,
+		BaseDelay:    30 * time.Second,
+		MaxDelay:     15 * time.Minute,
+		ResetAfter:   time.Hour,
+	}
+)
+
+// LoginLimiter locks login attempts per account and per IP address after
+// repeated failures.
+type LoginLimiter struct {
+	Account *ratelimit.Limiter
+	IP      *ratelimit.Limiter
+}
+
+func NewLoginLimiter(store ratelimit.Store) *LoginLimiter {
+	return &LoginLimiter{
+		Account: ratelimit.NewLimiter(store, "login:account:", accountLoginPolicy),
+		IP:      ratelimit.NewLimiter(store, "login:ip:", ipLoginPolicy),
+	}
+}
+
+// allow returns how long the client has to wait, the longer of the two locks.
+func (l *LoginLimiter) allow(ctx context.Context, email, ip string) (time.Duration, error) {
+	accountRetryAfter, err := l.Account.Allow(ctx, accountKey(email))
+	if err != nil {
+		return 0, err
+	}
+	ipRetryAfter, err := l.IP.Allow(ctx, ip)
+	if err != nil {
+		return 0, err
+	}
+	return max(accountRetryAfter, ipRetryAfter), nil
+}
+
+func (l *LoginLimiter) fail(ctx context.Context, email, ip string) error {
+	if _, err := l.Account.Fail(ctx, accountKey(email)); err != nil {
+		return err
+	}
+	_, err := l.IP.Fail(ctx, ip)
+	return err
+}
+
+// 一つのアカウントでログインできても IP の失敗は消さない
+func (l *LoginLimiter) succeed(ctx context.Context, email string) error {
+	return l.Account.Reset(ctx, accountKey(email))
+}
+
+func accountKey(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// Retry-After は秒単位なので切り上げる
+func retryAfterSeconds(d time.Duration) int {
+	return max(1, int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,231 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/takuchi17/term-keeper/api"
+	"github.com/takuchi17/term-keeper/app/models"
+	"github.com/takuchi17/term-keeper/middleware"
+	"github.com/takuchi17/term-keeper/pkg/mailer"
+	"github.com/takuchi17/term-keeper/pkg/util"
+)
+
+func (h *UserHandeler) GetMe(ctx context.Context, request api.GetMeRequestObject) (api.GetMeResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.GetMe401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	user, err := models.GetUserById(h.DB, models.UserId(userId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by id: %w", err)
+	}
+
+	return api.GetMe200JSONResponse(toUserResponse(user)), nil
+}
+
+func (h *UserHandeler) UpdateMe(ctx context.Context, request api.UpdateMeRequestObject) (api.UpdateMeResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.UpdateMe401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	user, err := models.UpdateUserName(h.DB, models.UserId(userId), models.UserName(request.Body.Username))
+	if violations := userValidationErrors(err); len(violations) > 0 {
+		return api.UpdateMe400JSONResponse{Message: "Invalid user", Errors: &violations}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update user name: %w", err)
+	}
+
+	return api.UpdateMe200JSONResponse(toUserResponse(user)), nil
+}
+
+func (h *UserHandeler) ChangePassword(ctx context.Context, request api.ChangePasswordRequestObject) (api.ChangePasswordResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.ChangePassword401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	// 二段階認証のコードを使う前に新しいパスワードを確かめる
+	if violations := newPasswordValidationErrors(models.ValidatePassword(models.Password(request.Body.NewPassword))); len(violations) > 0 {
+		return api.ChangePassword400JSONResponse{Message: "Invalid password", Errors: &violations}, nil
+	}
+
+	retryAfter, err := reauthenticate(ctx, h.DB, h.LoginLimiter, models.UserId(userId), request.Body.CurrentPassword, util.Deref(request.Body.Code))
+	switch {
+	case retryAfter > 0:
+		return api.ChangePassword429JSONResponse{
+			Body:    api.ErrorResponse{Message: "Too many failed attempts"},
+			Headers: api.ChangePassword429ResponseHeaders{RetryAfter: retryAfterSeconds(retryAfter)},
+		}, nil
+	case errors.Is(err, errForbidden):
+		return api.ChangePassword403JSONResponse{Message: "Invalid password or code"}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	if err := models.ChangePassword(h.DB, models.UserId(userId), models.Password(request.Body.NewPassword)); err != nil {
+		return nil, fmt.Errorf("failed to change password: %w", err)
+	}
+	user, err := models.GetUserById(h.DB, models.UserId(userId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by id: %w", err)
+	}
+
+	// このセッションだけは続けられるように新しいトークンを返す
+	var mode *api.SessionMode
+	if request.Params.TkRefresh != nil {
+		mode = util.Ptr(api.Cookie)
+	}
+	body, cookies, err := h.newSession(user, mode)
+	if err != nil {
+		return nil, err
+	}
+	if cookies == nil {
+		return api.ChangePassword200JSONResponse(body), nil
+	}
+	return changePasswordWithCookies{ChangePassword200JSONResponse: api.ChangePassword200JSONResponse(body), cookies: cookies}, nil
+}
+
+func (h *UserHandeler) ChangeEmail(ctx context.Context, request api.ChangeEmailRequestObject) (api.ChangeEmailResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.ChangeEmail401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	retryAfter, err := reauthenticate(ctx, h.DB, h.LoginLimiter, models.UserId(userId), request.Body.Password, util.Deref(request.Body.Code))
+	switch {
+	case retryAfter > 0:
+		return api.ChangeEmail429JSONResponse{
+			Body:    api.ErrorResponse{Message: "Too many failed attempts"},
+			Headers: api.ChangeEmail429ResponseHeaders{RetryAfter: retryAfterSeconds(retryAfter)},
+		}, nil
+	case errors.Is(err, errForbidden):
+		return api.ChangeEmail403JSONResponse{Message: "Invalid password or code"}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	user, err := models.GetUserById(h.DB, models.UserId(userId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by id: %w", err)
+	}
+
+	newEmail := models.Email(request.Body.Email)
+	token, err := models.RequestEmailChange(h.DB, user.ID, newEmail)
+	if violations := userValidationErrors(err); len(violations) > 0 {
+		return api.ChangeEmail400JSONResponse{Message: "Invalid email", Errors: &violations}, nil
+	}
+	switch {
+	case errors.Is(err, models.ErrEmailUnchanged):
+		return api.ChangeEmail400JSONResponse{Message: "Email is the same as the current one"}, nil
+	case errors.Is(err, models.ErrEmailAlreadyUsed):
+		return api.ChangeEmail409JSONResponse{Message: "Email is already used"}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to request email change: %w", err)
+	}
+
+	// 申し込みは済んでいるので、メールを送れなくてもやり直してもらえばよい
+	err = h.Mailer.Send(ctx, mailer.Message{
+		To:      string(newEmail),
+		Subject: "Confirm your new Term Keeper email address",
+		Body: "Open the link below within 24 hours to use this address for your account.\n\n" +
+			h.linkWithToken("/verify-email", token) + "\n",
+	})
+	if err != nil {
+		slog.Error("Failed to send email change mail", "userId", user.ID, "err", err)
+	}
+	// 乗っ取られたときに気づけるように今のアドレスにも知らせる
+	err = h.Mailer.Send(ctx, mailer.Message{
+		To:      string(user.Email),
+		Subject: "Your Term Keeper email address is being changed",
+		Body: "A change of the email address of your account was requested.\n" +
+			"It takes effect when the link sent to the new address is opened.\n\n" +
+			"If you did not request it, change your password right away.\n",
+	})
+	if err != nil {
+		slog.Error("Failed to send email change notice", "userId", user.ID, "err", err)
+	}
+
+	return api.ChangeEmail202Response{}, nil
+}
+
+func (h *UserHandeler) RequestAccountDeletion(ctx context.Context, request api.RequestAccountDeletionRequestObject) (api.RequestAccountDeletionResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.RequestAccountDeletion401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	retryAfter, err := reauthenticate(ctx, h.DB, h.LoginLimiter, models.UserId(userId), request.Body.Password, util.Deref(request.Body.Code))
+	switch {
+	case retryAfter > 0:
+		return api.RequestAccountDeletion429JSONResponse{
+			Body:    api.ErrorResponse{Message: "Too many failed attempts"},
+			Headers: api.RequestAccountDeletion429ResponseHeaders{RetryAfter: retryAfterSeconds(retryAfter)},
+		}, nil
+	case errors.Is(err, errForbidden):
+		return api.RequestAccountDeletion403JSONResponse{Message: "Invalid password or code"}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	token, err := models.IssueAccountDeletionToken(h.DB, models.UserId(userId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue account deletion token: %w", err)
+	}
+
+	return api.RequestAccountDeletion200JSONResponse{
+		ConfirmationToken: token,
+		ExpiresIn:         int(models.AccountDeletionTTL.Seconds()),
+	}, nil
+}
+
+func (h *UserHandeler) DeleteMe(ctx context.Context, request api.DeleteMeRequestObject) (api.DeleteMeResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.DeleteMe401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	err := models.DeleteUser(h.DB, models.UserId(userId), request.Body.ConfirmationToken)
+	if errors.Is(err, models.ErrInvalidUserToken) {
+		slog.Warn("Failed to delete user", "userId", userId, "err", err)
+		return api.DeleteMe400JSONResponse{Message: "Invalid or expired token"}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	slog.Info("User deleted", "userId", userId)
+	return deleteMeWithCookies{cookies: h.SessionCookie.clearCookies()}, nil
+}
+
+// 本文の項目名は new_password
+func newPasswordValidationErrors(err error) []api.ValidationError {
+	violations := userValidationErrors(err)
+	for i := range violations {
+		violations[i].Field = "new_password"
+	}
+	return violations
+}
+
+func toUserResponse(user *models.User) api.UserResponse {
+	return api.UserResponse{
+		Id:              string(user.ID),
+		Username:        string(user.Name),
+		Email:           openapi_types.Email(user.Email),
+		EmailVerifiedAt: user.EmailVerifiedAt,
+		PendingEmail:    (*openapi_types.Email)(user.PendingEmail),
+		CreatedAt:       user.CreatedAt,
+		UpdatedAt:       user.UpdatedAt,
+	}
+}
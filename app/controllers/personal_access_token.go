@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/takuchi17/term-keeper/api"
+	"github.com/takuchi17/term-keeper/app/models"
+	"github.com/takuchi17/term-keeper/middleware"
+)
+
+type PersonalAccessTokenHandler struct {
+	DB models.SQLExecutor
+}
+
+func (h *PersonalAccessTokenHandler) GetPersonalAccessTokens(ctx context.Context, request api.GetPersonalAccessTokensRequestObject) (api.GetPersonalAccessTokensResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.GetPersonalAccessTokens401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	tokens, err := models.GetPersonalAccessTokensByUserId(h.DB, models.UserId(userId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get personal access tokens: %w", err)
+	}
+
+	response := make([]api.PersonalAccessTokenResponse, len(tokens))
+	for i, token := range tokens {
+		response[i] = toPersonalAccessTokenResponse(token)
+	}
+
+	return api.GetPersonalAccessTokens200JSONResponse(response), nil
+}
+
+func (h *PersonalAccessTokenHandler) CreatePersonalAccessToken(ctx context.Context, request api.CreatePersonalAccessTokenRequestObject) (api.CreatePersonalAccessTokenResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.CreatePersonalAccessToken401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	scopes := make([]models.PersonalAccessTokenScope, len(request.Body.Scopes))
+	for i, scope := range request.Body.Scopes {
+		scopes[i] = models.PersonalAccessTokenScope(scope)
+	}
+
+	token, plainToken, err := models.CreatePersonalAccessToken(
+		h.DB,
+		models.UserId(userId),
+		models.PersonalAccessTokenName(request.Body.Name),
+		scopes,
+		request.Body.ExpiresAt,
+	)
+	switch {
+	case errors.Is(err, models.ErrPersonalAccessTokenNameRequired),
+		errors.Is(err, models.ErrPersonalAccessTokenNameTooLong),
+		errors.Is(err, models.ErrInvalidScope),
+		errors.Is(err, models.ErrScopeRequired),
+		errors.Is(err, models.ErrPersonalAccessTokenExpired),
+		errors.Is(err, models.ErrTooManyPersonalAccessTokens):
+		slog.Warn("Invalid personal access token", "err", err)
+		return api.CreatePersonalAccessToken400JSONResponse{Message: err.Error()}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to create personal access token: %w", err)
+	}
+
+	response := toPersonalAccessTokenResponse(token)
+	return api.CreatePersonalAccessToken201JSONResponse{
+		Id:          response.Id,
+		Name:        response.Name,
+		TokenPrefix: response.TokenPrefix,
+		Scopes:      response.Scopes,
+		ExpiresAt:   response.ExpiresAt,
+		LastUsedAt:  response.LastUsedAt,
+		CreatedAt:   response.CreatedAt,
+		Token:       plainToken,
+	}, nil
+}
+
+func (h *PersonalAccessTokenHandler) RevokePersonalAccessToken(ctx context.Context, request api.RevokePersonalAccessTokenRequestObject) (api.RevokePersonalAccessTokenResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.RevokePersonalAccessToken401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	err := models.RevokePersonalAccessToken(h.DB, models.UserId(userId), models.PersonalAccessTokenId(request.Id))
+	if errors.Is(err, models.ErrPersonalAccessTokenNotFound) {
+		slog.Warn("Personal access token not found", "id", request.Id)
+		return api.RevokePersonalAccessToken404JSONResponse{Message: "Personal access token not found"}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke personal access token: %w", err)
+	}
+
+	return api.RevokePersonalAccessToken204Response{}, nil
+}
+
+func toPersonalAccessTokenResponse(token *models.PersonalAccessToken) api.PersonalAccessTokenResponse {
+	scopes := make([]api.PersonalAccessTokenScope, len(token.Scopes))
+	for i, scope := range token.Scopes {
+		scopes[i] = api.PersonalAccessTokenScope(scope)
+	}
+
+	return api.PersonalAccessTokenResponse{
+		Id:          string(token.ID),
+		Name:        string(token.Name),
+		TokenPrefix: token.TokenPrefix,
+		Scopes:      scopes,
+		ExpiresAt:   token.ExpiresAt,
+		LastUsedAt:  token.LastUsedAt,
+		CreatedAt:   token.CreatedAt,
+	}
+}
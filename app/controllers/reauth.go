@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/takuchi17/term-keeper/app/models"
+	"github.com/takuchi17/term-keeper/middleware"
+)
+
+// reauthenticate checks the password of the signed-in user, and also the
+// two-factor code when the user has enabled it, before a sensitive change. A
+// wrong password or code returns errForbidden and counts as a failed login so
+// that a stolen session cannot guess them.
+func reauthenticate(ctx context.Context, db models.SQLExecutor, limiter *LoginLimiter, userId models.UserId, password string, code string) (time.Duration, error) {
+	ip, _ := middleware.GetClientIP(ctx)
+
+	user, err := models.GetUserById(db, userId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get user by id: %w", err)
+	}
+	retryAfter, err := limiter.allow(ctx, string(user.Email), ip)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check login limit: %w", err)
+	}
+	if retryAfter > 0 {
+		slog.Warn("Reauthentication locked", "userId", userId, "retryAfter", retryAfter)
+		return retryAfter, nil
+	}
+
+	ok, err := models.IsUserPassword(db, userId, models.Password(password))
+	if err != nil {
+		return 0, fmt.Errorf("failed to check password: %w", err)
+	}
+	if !ok {
+		slog.Warn("Invalid password on reauthentication", "userId", userId)
+		return 0, reauthenticationFailed(ctx, limiter, user.Email, ip)
+	}
+
+	// 二段階認証を有効にしていなければコードは見ない
+	err = models.VerifyTwoFactorCode(db, userId, code)
+	switch {
+	case errors.Is(err, models.ErrTwoFactorNotEnabled):
+		return 0, nil
+	case errors.Is(err, models.ErrInvalidTwoFactorCode):
+		slog.Warn("Invalid two-factor code on reauthentication", "userId", userId)
+		return 0, reauthenticationFailed(ctx, limiter, user.Email, ip)
+	case err != nil:
+		return 0, fmt.Errorf("failed to verify two-factor code: %w", err)
+	}
+	return 0, nil
+}
+
+func reauthenticationFailed(ctx context.Context, limiter *LoginLimiter, email models.Email, ip string) error {
+	if err := limiter.fail(ctx, string(email), ip); err != nil {
+		return fmt.Errorf("failed to record login failure: %w", err)
+	}
+	return errForbidden
+}
@@ -0,0 +1,137 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/takuchi17/term-keeper/api"
+	"github.com/takuchi17/term-keeper/app/models"
+	"github.com/takuchi17/term-keeper/middleware"
+	"github.com/takuchi17/term-keeper/pkg/srs"
+	"github.com/takuchi17/term-keeper/pkg/util"
+)
+
+// api/openapi.yaml の limit の既定値
+const defaultReviewsLimit = 20
+
+type ReviewHandler struct {
+	DB        models.SQLExecutor
+	Scheduler *srs.Scheduler
+}
+
+func (h *ReviewHandler) GetDueReviews(ctx context.Context, request api.GetDueReviewsRequestObject) (api.GetDueReviewsResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.GetDueReviews401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	limit := defaultReviewsLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	// 予定を決めるのと同じ時計で比べる
+	reviews, total, err := models.GetDueReviews(h.DB, models.TermUserId(userId), h.Scheduler.Now(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due reviews: %w", err)
+	}
+
+	items := make([]api.DueReviewResponse, len(reviews))
+	for i, review := range reviews {
+		items[i] = api.DueReviewResponse{
+			TermId: string(review.TermId),
+			Name:   string(review.TermName),
+		}
+		if review.TermDescription != "" {
+			items[i].Description = util.Ptr(string(review.TermDescription))
+		}
+		if review.Card != nil {
+			items[i].Schedule = util.Ptr(toReviewScheduleResponse(review.Card))
+		}
+	}
+
+	return api.GetDueReviews200JSONResponse{Items: items, DueCount: total}, nil
+}
+
+func (h *ReviewHandler) ReviewTerm(ctx context.Context, request api.ReviewTermRequestObject) (api.ReviewTermResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.ReviewTerm401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	term, err := models.GetTermById(h.DB, models.TermId(request.TermId))
+	if errors.Is(err, sql.ErrNoRows) {
+		slog.Warn("Term not found", "termId", request.TermId)
+		return api.ReviewTerm404JSONResponse{Message: "Term not found"}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get term: %w", err)
+	}
+	if term.FKUserId != models.TermUserId(userId) {
+		slog.Warn("Term belongs to another user", "termId", term.ID, "userId", userId)
+		return api.ReviewTerm403JSONResponse{Message: "Forbidden"}, nil
+	}
+
+	card, err := models.ReviewTerm(h.DB, h.Scheduler, term.ID, term.FKUserId, srs.Grade(request.Body.Grade))
+	if errors.Is(err, srs.ErrInvalidGrade) {
+		return api.ReviewTerm400JSONResponse{Message: "Invalid grade"}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to review term: %w", err)
+	}
+
+	return api.ReviewTerm200JSONResponse(toReviewScheduleResponse(card)), nil
+}
+
+func (h *ReviewHandler) GetReviewHistory(ctx context.Context, request api.GetReviewHistoryRequestObject) (api.GetReviewHistoryResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.GetReviewHistory401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	limit := defaultReviewsLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+	var termId *models.TermId
+	if request.Params.TermId != nil {
+		termId = util.Ptr(models.TermId(*request.Params.TermId))
+	}
+
+	logs, err := models.GetReviewLogs(h.DB, models.TermUserId(userId), termId, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review logs: %w", err)
+	}
+
+	items := make([]api.ReviewLogResponse, len(logs))
+	for i, log := range logs {
+		items[i] = api.ReviewLogResponse{
+			Id:           string(log.ID),
+			TermId:       string(log.FKTermId),
+			Grade:        api.ReviewGrade(log.Grade),
+			Ease:         log.Ease,
+			IntervalDays: log.IntervalDays,
+			DueAt:        log.DueAt,
+			ReviewedAt:   log.ReviewedAt,
+		}
+	}
+
+	return api.GetReviewHistory200JSONResponse{Items: items}, nil
+}
+
+func toReviewScheduleResponse(card *srs.Card) api.ReviewScheduleResponse {
+	return api.ReviewScheduleResponse{
+		Ease:           card.Ease,
+		IntervalDays:   card.IntervalDays,
+		Repetitions:    card.Repetitions,
+		Lapses:         card.Lapses,
+		DueAt:          card.Due,
+		LastReviewedAt: util.Deref(card.LastReviewedAt),
+	}
+}
@@ -0,0 +1,79 @@
+package controllers
+
+import (
+	"errors"
+	"time"
+
+	"github.com/takuchi17/term-keeper/api"
+	"github.com/takuchi17/term-keeper/app/models"
+	"github.com/takuchi17/term-keeper/pkg/mailer"
+	"github.com/takuchi17/term-keeper/pkg/ratelimit"
+	"github.com/takuchi17/term-keeper/pkg/srs"
+)
+
+var (
+	errNotFound  = errors.New("not found")
+	errForbidden = errors.New("forbidden")
+)
+
+// Server implements every operation of api/openapi.yaml by embedding the
+// resource handlers. Adding an operation to the spec breaks the build here
+// until one of the handlers implements it.
+type Server struct {
+	*UserHandeler
+	*AuthHandler
+	*PersonalAccessTokenHandler
+	*TwoFactorHandler
+	*TermHandler
+	*ReviewHandler
+	*CategoryHandler
+	*TrashHandler
+}
+
+var _ api.StrictServerInterface = (*Server)(nil)
+
+// ServerOptions are the settings of the handlers that do not come from the
+// database.
+type ServerOptions struct {
+	Mailer mailer.Mailer
+	// メールに載せるリンクの基点 (フロントエンドの URL)
+	AppBaseURL               string
+	RequireEmailVerification bool
+	// ログイン失敗回数の保存先。nil ならメモリに保存する
+	LoginLimiterStore ratelimit.Store
+	SessionCookie     SessionCookieOptions
+	// 復習の予定を決める。nil なら今の時刻で決める
+	ReviewScheduler *srs.Scheduler
+	// 用語のリビジョンを残す数と期間
+	TermRevisionRetention models.TermRevisionRetention
+	// ゴミ箱に残す期間。0 なら削除しない
+	TrashRetention time.Duration
+}
+
+func NewServer(db models.SQLExecutor, options ServerOptions) *Server {
+	if options.LoginLimiterStore == nil {
+		options.LoginLimiterStore = ratelimit.NewMemoryStore()
+	}
+	loginLimiter := NewLoginLimiter(options.LoginLimiterStore)
+	if options.ReviewScheduler == nil {
+		options.ReviewScheduler = srs.NewScheduler()
+	}
+
+	return &Server{
+		UserHandeler: &UserHandeler{
+			DB:                       db,
+			Mailer:                   options.Mailer,
+			AppBaseURL:               options.AppBaseURL,
+			RequireEmailVerification: options.RequireEmailVerification,
+			LoginLimiter:             loginLimiter,
+			SessionCookie:            options.SessionCookie,
+		},
+		AuthHandler:                &AuthHandler{DB: db, SessionCookie: options.SessionCookie},
+		PersonalAccessTokenHandler: &PersonalAccessTokenHandler{DB: db},
+		TwoFactorHandler:           &TwoFactorHandler{DB: db, LoginLimiter: loginLimiter},
+		TermHandler:                &TermHandler{DB: db, RevisionRetention: options.TermRevisionRetention},
+		ReviewHandler:              &ReviewHandler{DB: db, Scheduler: options.ReviewScheduler},
+		CategoryHandler:            &CategoryHandler{DB: db},
+		TrashHandler:               &TrashHandler{DB: db, Retention: options.TrashRetention},
+	}
+}
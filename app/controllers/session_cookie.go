@@ -0,0 +1,143 @@
+package controllers
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+
+	"github.com/takuchi17/term-keeper/api"
+	"github.com/takuchi17/term-keeper/app/models"
+	"github.com/takuchi17/term-keeper/middleware"
+	"github.com/takuchi17/term-keeper/pkg/jwt"
+)
+
+// SessionCookieOptions are the attributes of the cookies of the cookie session
+// mode, see the description of /login in api/openapi.yaml.
+type SessionCookieOptions struct {
+	// false only for development over plain HTTP
+	Secure   bool
+	SameSite http.SameSite
+	// フロントエンドと API のサブドメインが違うときに共通の親ドメインを指定する
+	Domain string
+}
+
+// API の Cookie はフロントエンドのページに送る必要がない
+const sessionCookiePath = "/api/v1"
+
+// sessionCookies returns the cookies that log the browser in. The CSRF cookie
+// is readable by JavaScript on every path of the frontend.
+func (o SessionCookieOptions) sessionCookies(accessToken, refreshToken, csrfToken string) []*http.Cookie {
+	return []*http.Cookie{
+		o.cookie(middleware.SessionCookieName, accessToken, sessionCookiePath, int(jwt.AccessTokenTTL.Seconds()), true),
+		o.cookie(middleware.RefreshCookieName, refreshToken, sessionCookiePath, int(models.RefreshTokenTTL.Seconds()), true),
+		o.cookie(middleware.CSRFCookieName, csrfToken, "/", int(models.RefreshTokenTTL.Seconds()), false),
+	}
+}
+
+// clearCookies returns cookies that delete the session cookies.
+func (o SessionCookieOptions) clearCookies() []*http.Cookie {
+	return []*http.Cookie{
+		o.cookie(middleware.SessionCookieName, "", sessionCookiePath, -1, true),
+		o.cookie(middleware.RefreshCookieName, "", sessionCookiePath, -1, true),
+		o.cookie(middleware.CSRFCookieName, "", "/", -1, false),
+	}
+}
+
+func (o SessionCookieOptions) cookie(name, value, path string, maxAge int, httpOnly bool) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		Domain:   o.Domain,
+		MaxAge:   maxAge,
+		Secure:   o.Secure,
+		HttpOnly: httpOnly,
+		SameSite: o.SameSite,
+	}
+}
+
+func newCSRFToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// 生成されたレスポンスは Set-Cookie を複数返せないので、書き出す前に Cookie を付ける
+
+type loginUserWithCookies struct {
+	api.LoginUser200JSONResponse
+	cookies []*http.Cookie
+}
+
+func (r loginUserWithCookies) VisitLoginUserResponse(w http.ResponseWriter) error {
+	setCookies(w, r.cookies)
+	return r.LoginUser200JSONResponse.VisitLoginUserResponse(w)
+}
+
+type loginTwoFactorWithCookies struct {
+	api.LoginTwoFactor200JSONResponse
+	cookies []*http.Cookie
+}
+
+func (r loginTwoFactorWithCookies) VisitLoginTwoFactorResponse(w http.ResponseWriter) error {
+	setCookies(w, r.cookies)
+	return r.LoginTwoFactor200JSONResponse.VisitLoginTwoFactorResponse(w)
+}
+
+type refreshTokenWithCookies struct {
+	api.RefreshToken200JSONResponse
+	cookies []*http.Cookie
+}
+
+func (r refreshTokenWithCookies) VisitRefreshTokenResponse(w http.ResponseWriter) error {
+	setCookies(w, r.cookies)
+	return r.RefreshToken200JSONResponse.VisitRefreshTokenResponse(w)
+}
+
+type logoutUserWithCookies struct {
+	api.LogoutUser204Response
+	cookies []*http.Cookie
+}
+
+func (r logoutUserWithCookies) VisitLogoutUserResponse(w http.ResponseWriter) error {
+	setCookies(w, r.cookies)
+	return r.LogoutUser204Response.VisitLogoutUserResponse(w)
+}
+
+type logoutAllSessionsWithCookies struct {
+	api.LogoutAllSessions204Response
+	cookies []*http.Cookie
+}
+
+func (r logoutAllSessionsWithCookies) VisitLogoutAllSessionsResponse(w http.ResponseWriter) error {
+	setCookies(w, r.cookies)
+	return r.LogoutAllSessions204Response.VisitLogoutAllSessionsResponse(w)
+}
+
+type changePasswordWithCookies struct {
+	api.ChangePassword200JSONResponse
+	cookies []*http.Cookie
+}
+
+func (r changePasswordWithCookies) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	setCookies(w, r.cookies)
+	return r.ChangePassword200JSONResponse.VisitChangePasswordResponse(w)
+}
+
+type deleteMeWithCookies struct {
+	api.DeleteMe204Response
+	cookies []*http.Cookie
+}
+
+func (r deleteMeWithCookies) VisitDeleteMeResponse(w http.ResponseWriter) error {
+	setCookies(w, r.cookies)
+	return r.DeleteMe204Response.VisitDeleteMeResponse(w)
+}
+
+func setCookies(w http.ResponseWriter, cookies []*http.Cookie) {
+	for _, cookie := range cookies {
+		http.SetCookie(w, cookie)
+	}
+}
@@ -1,123 +1,464 @@
 package controllers
 
 import (
-	"encoding/json"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 	"log/slog"
-	"net/http"
+	"strings"
+	"time"
 
 	"github.com/takuchi17/term-keeper/api"
 	"github.com/takuchi17/term-keeper/app/models"
 	"github.com/takuchi17/term-keeper/middleware"
 
-	"github.com/takuchi17/term-keeper/pkg/http_checker"
+	"github.com/takuchi17/term-keeper/pkg/snippet"
 	"github.com/takuchi17/term-keeper/pkg/util"
 )
 
+// api/openapi.yaml の limit の既定値
+const defaultTermsLimit = 20
+
 type TermHandler struct {
-	DB models.SQLExecutor
+	DB                models.SQLExecutor
+	RevisionRetention models.TermRevisionRetention
 }
 
-func (h *TermHandler) Create(w http.ResponseWriter, r *http.Request) {
-	var requestBody api.CreateTermJSONRequestBody
-	if err := http_checker.CheckRequest(w, r, &requestBody); err != nil {
-		slog.Warn("Failed to check request", "err", err)
-		return
+func (h *TermHandler) CreateTerm(ctx context.Context, request api.CreateTermRequestObject) (api.CreateTermResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.CreateTerm401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	var categoryIds []models.CategoryId
+	if request.Body.CategoryIds != nil {
+		for _, categoryId := range *request.Body.CategoryIds {
+			categoryIds = append(categoryIds, models.CategoryId(categoryId))
+		}
+	}
+
+	var description models.TermDescription
+	if request.Body.Description != nil {
+		description = models.TermDescription(*request.Body.Description)
+	}
+
+	// 用語とソースは一緒に作成する
+	var createdTerm *models.Term
+	err := models.WithTx(h.DB, func(tx models.SQLExecutor) error {
+		var err error
+		createdTerm, err = models.CreateTerm(
+			tx,
+			models.TermUserId(userId),
+			models.TermName(request.Body.Name),
+			description,
+			categoryIds,
+		)
+		if err != nil {
+			return err
+		}
+
+		// 新しい用語は unread なので、それ以外のときだけ変更として残す
+		if request.Body.Status != nil && models.TermStatus(*request.Body.Status) != models.TermStatusUnread {
+			if err := createdTerm.ChangeStatus(tx, models.TermStatus(*request.Body.Status)); err != nil {
+				return err
+			}
+		}
+
+		if request.Body.Sources == nil {
+			return nil
+		}
+		return models.ReplaceTermSources(tx, createdTerm.ID, toTermSources(*request.Body.Sources))
+	})
+
+	if errors.Is(err, models.ErrCategoryNotOwned) {
+		slog.Warn("Tried to link categories not owned by the user", "err", err)
+		return api.CreateTerm400JSONResponse{Message: "Invalid category ids"}, nil
+	}
+	if errors.Is(err, models.ErrInvalidTermStatus) {
+		slog.Warn("Invalid term status", "status", *request.Body.Status)
+		return api.CreateTerm400JSONResponse{Message: "Invalid term status"}, nil
+	}
+	if message, ok := sourceErrorMessage(err); ok {
+		slog.Warn("Invalid term sources", "err", err)
+		return api.CreateTerm400JSONResponse{Message: message}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create term: %w", err)
+	}
+
+	created, err := models.GetTermWithCategoriesById(h.DB, createdTerm.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get created term: %w", err)
 	}
 
-	userId, ok := middleware.GetUserID(r.Context())
+	return api.CreateTerm201JSONResponse(toTermResponse(created)), nil
+}
+
+func (h *TermHandler) GetTerms(ctx context.Context, request api.GetTermsRequestObject) (api.GetTermsResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
 	if !ok {
 		slog.Warn("Failed to get user ID from context")
-		http.Error(w, "Unauthorized", http.StatusUnauthorized)
-		return
+		return api.GetTerms401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	var sort *string
+	if request.Params.Sort != nil {
+		sort = util.Ptr(string(*request.Params.Sort))
+	}
+	var checked *string
+	if request.Params.Checked != nil {
+		checked = util.Ptr(fmt.Sprint(*request.Params.Checked))
+	}
+
+	limit := defaultTermsLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
 	}
 
 	var categoryIds []models.CategoryId
-	for _, categoryId := range *requestBody.CategoryIds {
-		categoryIds = append(categoryIds, models.CategoryId(categoryId))
+	if request.Params.Category != nil {
+		for _, categoryId := range *request.Params.Category {
+			categoryIds = append(categoryIds, models.CategoryId(categoryId))
+		}
+	}
+	var categoryMatch *string
+	if request.Params.CategoryMatch != nil {
+		categoryMatch = util.Ptr(string(*request.Params.CategoryMatch))
+	}
+	var searchMode *string
+	if request.Params.SearchMode != nil {
+		searchMode = util.Ptr(string(*request.Params.SearchMode))
+	}
+	var statuses []models.TermStatus
+	if request.Params.Status != nil {
+		for _, status := range *request.Params.Status {
+			statuses = append(statuses, models.TermStatus(status))
+		}
 	}
 
-	createdTerm, err := models.CreateTerm(
+	page, err := models.GetTermsPageWithCategoriesByUserId(
 		h.DB,
 		models.TermUserId(userId),
-		models.TermName(requestBody.Name),
-		models.TermDescription(*requestBody.Description),
-		categoryIds,
+		models.TermListOptions{
+			Query:         request.Params.Query,
+			SearchMode:    searchMode,
+			Categories:    categoryIds,
+			CategoryMatch: categoryMatch,
+			Uncategorized: request.Params.Uncategorized != nil && *request.Params.Uncategorized,
+			SourceDomain:  request.Params.Domain,
+			Sort:          sort,
+			Checked:       checked,
+			Statuses:      statuses,
+			Limit:         limit,
+			Cursor:        request.Params.Cursor,
+		},
 	)
+	if errors.Is(err, models.ErrInvalidCursor) {
+		slog.Warn("Invalid cursor for terms", "cursor", *request.Params.Cursor)
+		return api.GetTerms400JSONResponse{Message: "Invalid cursor"}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get terms: %w", err)
+	}
+
+	var words []string
+	if request.Params.Query != nil {
+		words = strings.Fields(*request.Params.Query)
+	}
+
+	terms := make([]api.TermResponse, len(page.Terms))
+	for i, termAndCategories := range page.Terms {
+		terms[i] = toTermResponse(termAndCategories)
+		terms[i].Snippets = toTermSnippets(termAndCategories.Term, words)
+	}
+
+	response := api.GetTerms200JSONResponse{
+		Items:      terms,
+		TotalCount: page.TotalCount,
+	}
+	if page.NextCursor != "" {
+		response.NextCursor = &page.NextCursor
+	}
+
+	return response, nil
+}
+
+func (h *TermHandler) UpdateTerm(ctx context.Context, request api.UpdateTermRequestObject) (api.UpdateTermResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.UpdateTerm401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	termId := models.TermId(request.Id)
+	if request.Body.Id != string(termId) {
+		slog.Warn("Term ID mismatch between path and body", "path", termId, "body", request.Body.Id)
+		return api.UpdateTerm400JSONResponse{Message: "Term ID in path and body do not match"}, nil
+	}
+
+	if request.Body.Name != nil && *request.Body.Name == "" {
+		slog.Warn("Empty term name in update request")
+		return api.UpdateTerm400JSONResponse{Message: "Term name must not be empty"}, nil
+	}
+
+	// 確認してから更新するまでに消されないように、同じトランザクションで確認する
+	err := models.WithTx(h.DB, func(tx models.SQLExecutor) error {
+		current, err := findOwnTerm(tx, termId, models.TermUserId(userId))
+		if err != nil {
+			return err
+		}
+
+		term := current.Term
+		if request.Body.Name != nil {
+			term.Name = models.TermName(*request.Body.Name)
+		}
+		if request.Body.Description != nil {
+			term.Description = models.TermDescription(*request.Body.Description)
+		}
+		term.UpdatedAt = util.Ptr(time.Now())
+
+		// keep the current categories unless the client sent a new list
+		var categoryIds []models.CategoryId
+		if request.Body.CategoryIds != nil {
+			for _, categoryId := range *request.Body.CategoryIds {
+				categoryIds = append(categoryIds, models.CategoryId(categoryId))
+			}
+		} else {
+			for _, category := range current.Categories {
+				categoryIds = append(categoryIds, category.ID)
+			}
+		}
+
+		if _, err := term.UpdateWithRevision(tx, categoryIds, models.UserId(userId), h.RevisionRetention); err != nil {
+			return err
+		}
 
+		if request.Body.Status != nil {
+			if err := term.ChangeStatus(tx, models.TermStatus(*request.Body.Status)); err != nil {
+				return err
+			}
+		}
+
+		// keep the current sources unless the client sent a new list
+		if request.Body.Sources == nil {
+			return nil
+		}
+		return models.ReplaceTermSources(tx, term.ID, toTermSources(*request.Body.Sources))
+	})
+	if errors.Is(err, errNotFound) {
+		return api.UpdateTerm404JSONResponse{Message: "Term not found"}, nil
+	}
+	if errors.Is(err, errForbidden) {
+		return api.UpdateTerm403JSONResponse{Message: "Forbidden"}, nil
+	}
+	if errors.Is(err, models.ErrCategoryNotOwned) {
+		slog.Warn("Tried to link categories not owned by the user", "err", err)
+		return api.UpdateTerm400JSONResponse{Message: "Invalid category ids"}, nil
+	}
+	if errors.Is(err, models.ErrInvalidTermStatus) {
+		slog.Warn("Invalid term status", "status", *request.Body.Status)
+		return api.UpdateTerm400JSONResponse{Message: "Invalid term status"}, nil
+	}
+	if message, ok := sourceErrorMessage(err); ok {
+		slog.Warn("Invalid term sources", "err", err)
+		return api.UpdateTerm400JSONResponse{Message: message}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update term: %w", err)
+	}
+
+	updated, err := models.GetTermWithCategoriesById(h.DB, termId)
 	if err != nil {
-		slog.Error("Failed to create term", "err", err)
-		errorResponse := api.ErrorResponse{Message: "Failed to create term"}
-		errorJSON, _ := json.Marshal(errorResponse)
-		w.Header().Set("Content-Type", "application/json")
-		http.Error(w, string(errorJSON), http.StatusInternalServerError)
-		return
-	}
-
-	json.NewEncoder(w).Encode(api.TermResponse{
-		Id:          nil,
-		Name:        util.Ptr(string(createdTerm.Name)),
-		Description: nil,
-		CreatedAt:   nil,
-		UpdatedAt:   nil,
-		Categories:  nil,
+		return nil, fmt.Errorf("failed to get updated term: %w", err)
+	}
+
+	return api.UpdateTerm200JSONResponse(toTermResponse(updated)), nil
+}
+
+func (h *TermHandler) DeleteTerm(ctx context.Context, request api.DeleteTermRequestObject) (api.DeleteTermResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.DeleteTerm401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	err := models.WithTx(h.DB, func(tx models.SQLExecutor) error {
+		current, err := findOwnTerm(tx, models.TermId(request.Id), models.TermUserId(userId))
+		if err != nil {
+			return err
+		}
+		if err := current.Term.Delete(tx); err != nil {
+			return fmt.Errorf("failed to delete term: %w", err)
+		}
+		return nil
 	})
-	w.WriteHeader(http.StatusCreated)
+	switch {
+	case errors.Is(err, errNotFound):
+		return api.DeleteTerm404JSONResponse{Message: "Term not found"}, nil
+	case errors.Is(err, errForbidden):
+		return api.DeleteTerm403JSONResponse{Message: "Forbidden"}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return api.DeleteTerm204Response{}, nil
 }
 
-func (h *TermHandler) Get(w http.ResponseWriter, r *http.Request) {
-	userId, ok := middleware.GetUserID(r.Context())
+func (h *TermHandler) GetTermStatusHistory(ctx context.Context, request api.GetTermStatusHistoryRequestObject) (api.GetTermStatusHistoryResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
 	if !ok {
 		slog.Warn("Failed to get user ID from context")
-		http.Error(w, "Unauthorized", http.StatusUnauthorized)
-		return
+		return api.GetTermStatusHistory401JSONResponse{Message: "Unauthorized"}, nil
 	}
 
-	requestParams := r.URL.Query()
-	query := requestParams.Get("query")
-	category := requestParams.Get("category")
-	sort := requestParams.Get("sort")
-	checkedStr := requestParams.Get("checked")
+	current, err := h.getOwnTerm(models.TermId(request.Id), models.TermUserId(userId))
+	switch {
+	case errors.Is(err, errNotFound):
+		return api.GetTermStatusHistory404JSONResponse{Message: "Term not found"}, nil
+	case errors.Is(err, errForbidden):
+		return api.GetTermStatusHistory403JSONResponse{Message: "Forbidden"}, nil
+	case err != nil:
+		return nil, err
+	}
 
-	var termsAndCategories []*models.TermAndCategories
-	termsAndCategories, err := models.GetTermsWithCategoriesByUserId(
-		h.DB,
-		models.TermUserId(userId),
-		&query,
-		&category,
-		&sort,
-		&checkedStr,
-	)
+	transitions, err := models.GetTermStatusTransitions(h.DB, current.Term.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get term status history: %w", err)
+	}
+
+	items := make([]api.TermStatusTransitionResponse, len(transitions))
+	for i, transition := range transitions {
+		items[i] = api.TermStatusTransitionResponse{
+			Id:         string(transition.ID),
+			FromStatus: api.TermStatus(transition.FromStatus),
+			ToStatus:   api.TermStatus(transition.ToStatus),
+			ChangedAt:  transition.ChangedAt,
+		}
+	}
+
+	return api.GetTermStatusHistory200JSONResponse{Items: items}, nil
+}
+
+// getOwnTerm loads the term with its categories and returns errNotFound or
+// errForbidden when it does not exist or belongs to another user.
+func (h *TermHandler) getOwnTerm(termId models.TermId, userId models.TermUserId) (*models.TermAndCategories, error) {
+	return findOwnTerm(h.DB, termId, userId)
+}
 
+// findOwnTerm is getOwnTerm on db, e.g. inside a transaction.
+func findOwnTerm(db models.SQLExecutor, termId models.TermId, userId models.TermUserId) (*models.TermAndCategories, error) {
+	termAndCategories, err := models.GetTermWithCategoriesById(db, termId)
+	if errors.Is(err, sql.ErrNoRows) {
+		slog.Warn("Term not found", "termId", termId)
+		return nil, errNotFound
+	}
 	if err != nil {
-		slog.Error("Failed to get terms", "err", err)
-		errorResponse := api.ErrorResponse{Message: "Failed to get terms"}
-		errorJSON, _ := json.Marshal(errorResponse)
-		w.Header().Set("Content-Type", "application/json")
-		http.Error(w, string(errorJSON), http.StatusInternalServerError)
-		return
-	}
-
-	terms := make([]api.TermResponse, len(termsAndCategories))
-	for i, termAndCategory := range termsAndCategories {
-		categories := make([]api.CategoryResponse, len(termAndCategory.Categories))
-		for j, category := range termAndCategory.Categories {
-			categories[j] = api.CategoryResponse{
-				Id:           util.Ptr(string(category.ID)),
-				Name:         util.Ptr(string(category.Name)),
-				HexColorCode: util.Ptr(string(category.HexColorCode)),
-				CreatedAt:    category.CreatedAt,
-				UpdatedAt:    category.UpdatedAt,
-			}
+		return nil, fmt.Errorf("failed to get term: %w", err)
+	}
+
+	if termAndCategories.Term.FKUserId != userId {
+		slog.Warn("Term belongs to another user", "termId", termId, "userId", userId)
+		return nil, errForbidden
+	}
+
+	return termAndCategories, nil
+}
+
+func toTermResponse(termAndCategories *models.TermAndCategories) api.TermResponse {
+	categories := make([]api.CategoryResponse, len(termAndCategories.Categories))
+	for i, category := range termAndCategories.Categories {
+		categories[i] = toCategoryResponse(category)
+	}
+	sources := make([]api.TermSourceResponse, len(termAndCategories.Sources))
+	for i, source := range termAndCategories.Sources {
+		sources[i] = toTermSourceResponse(source)
+	}
+	return api.TermResponse{
+		Id:              util.Ptr(string(termAndCategories.Term.ID)),
+		Name:            util.Ptr(string(termAndCategories.Term.Name)),
+		Description:     util.Ptr(string(termAndCategories.Term.Description)),
+		Status:          util.Ptr(api.TermStatus(termAndCategories.Term.Status)),
+		StatusChangedAt: termAndCategories.Term.StatusChangedAt,
+		CreatedAt:       termAndCategories.Term.CreatedAt,
+		UpdatedAt:       termAndCategories.Term.UpdatedAt,
+		Categories:      &categories,
+		Sources:         &sources,
+	}
+}
+
+func toTermSourceResponse(source *models.TermSource) api.TermSourceResponse {
+	response := api.TermSourceResponse{
+		Id:         string(source.ID),
+		Url:        string(source.URL),
+		Domain:     source.Domain,
+		CapturedAt: source.CapturedAt,
+	}
+	if source.Title != "" {
+		response.Title = util.Ptr(string(source.Title))
+	}
+	if source.Note != "" {
+		response.Note = util.Ptr(string(source.Note))
+	}
+	return response
+}
+
+func toTermSources(requests []api.TermSourceRequest) []*models.TermSource {
+	sources := make([]*models.TermSource, len(requests))
+	for i, request := range requests {
+		source := &models.TermSource{
+			URL:        models.TermSourceURL(request.Url),
+			CapturedAt: request.CapturedAt,
+		}
+		if request.Title != nil {
+			source.Title = models.TermSourceTitle(*request.Title)
 		}
-		terms[i] = api.TermResponse{
-			Id:          util.Ptr(string(termAndCategory.Term.ID)),
-			Name:        util.Ptr(string(termAndCategory.Term.Name)),
-			Description: util.Ptr(string(termAndCategory.Term.Description)),
-			CreatedAt:   termAndCategory.Term.CreatedAt,
-			UpdatedAt:   termAndCategory.Term.UpdatedAt,
-			Categories:  &categories,
+		if request.Note != nil {
+			source.Note = models.TermSourceNote(*request.Note)
 		}
+		sources[i] = source
 	}
+	return sources
+}
 
-	json.NewEncoder(w).Encode(api.TermListResponse(terms))
+// sourceErrorMessage returns the message for the client when err is caused
+// by invalid sources in the request.
+func sourceErrorMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, models.ErrInvalidSourceURL):
+		return "Invalid source url", true
+	case errors.Is(err, models.ErrTooManySources):
+		return "Too many sources", true
+	case errors.Is(err, models.ErrSourceTooLong):
+		return "Source title or note is too long", true
+	default:
+		return "", false
+	}
+}
+
+// 説明の抜粋の最大文字数
+const descriptionSnippetWidth = 80
+
+// toTermSnippets highlights the search words in the term, or returns nil when
+// nothing matched, e.g. without a query.
+func toTermSnippets(term *models.Term, words []string) *api.TermSnippets {
+	if len(words) == 0 {
+		return nil
+	}
+
+	var snippets api.TermSnippets
+	found := false
+	if name, ok := snippet.Highlight(string(term.Name), words); ok {
+		snippets.Name = &name
+		found = true
+	}
+	if description, ok := snippet.Excerpt(string(term.Description), words, descriptionSnippetWidth); ok {
+		snippets.Description = &description
+		found = true
+	}
+	if !found {
+		return nil
+	}
+	return &snippets
 }
@@ -0,0 +1,311 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/takuchi17/term-keeper/api"
+	"github.com/takuchi17/term-keeper/app/models"
+	"github.com/takuchi17/term-keeper/middleware"
+	"github.com/takuchi17/term-keeper/pkg/util"
+)
+
+// api/openapi.yaml の operations の maxItems
+const maxTermBatchSize = 100
+
+var (
+	errTermBatchIdRequired          = errors.New("term id is required")
+	errTermBatchCategoryIdsRequired = errors.New("category ids are required")
+	errTermBatchUnknownOp           = errors.New("unknown term batch operation")
+)
+
+func (h *TermHandler) BatchTerms(ctx context.Context, request api.BatchTermsRequestObject) (api.BatchTermsResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.BatchTerms401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	operations := request.Body.Operations
+	if len(operations) == 0 {
+		slog.Warn("Empty term batch")
+		return api.BatchTerms400JSONResponse{Message: "No operations"}, nil
+	}
+	if len(operations) > maxTermBatchSize {
+		slog.Warn("Too many operations in term batch", "count", len(operations))
+		return api.BatchTerms400JSONResponse{Message: "Too many operations"}, nil
+	}
+
+	mode := api.Atomic
+	if request.Body.Mode != nil {
+		mode = *request.Body.Mode
+	}
+
+	// 実行しなかった操作は skipped のまま返す
+	results := make([]api.TermBatchResult, len(operations))
+	for i, operation := range operations {
+		results[i] = api.TermBatchResult{Index: i, Op: string(operation.Op), Status: api.Skipped}
+	}
+
+	if mode == api.BestEffort {
+		return h.batchTermsBestEffort(models.TermUserId(userId), operations, results), nil
+	}
+	return h.batchTermsAtomic(models.TermUserId(userId), operations, results)
+}
+
+// batchTermsAtomic runs every operation in one transaction and rolls all of
+// them back on the first failure.
+func (h *TermHandler) batchTermsAtomic(userId models.TermUserId, operations []api.TermBatchOperation, results []api.TermBatchResult) (api.BatchTermsResponseObject, error) {
+	failed := -1
+	err := models.WithTx(h.DB, func(tx models.SQLExecutor) error {
+		for i, operation := range operations {
+			termId, err := h.applyTermBatchOperation(tx, userId, operation)
+			if err != nil {
+				failed = i
+				return err
+			}
+			results[i].Status = api.Ok
+			results[i].Id = util.Ptr(string(termId))
+		}
+		return nil
+	})
+	if err == nil {
+		return api.BatchTerms200JSONResponse{
+			Committed: true,
+			Succeeded: len(operations),
+			Failed:    0,
+			Results:   results,
+		}, nil
+	}
+
+	message, ok := termBatchErrorMessage(err)
+	if !ok {
+		return nil, fmt.Errorf("failed to apply term batch: %w", err)
+	}
+	slog.Warn("Term batch rolled back", "index", failed, "err", err)
+	markTermBatchRolledBack(operations, results, failed, message)
+
+	return api.BatchTerms200JSONResponse{
+		Committed: false,
+		Succeeded: 0,
+		Failed:    1,
+		Results:   results,
+	}, nil
+}
+
+// markTermBatchRolledBack sets the results of an atomic batch that failed at
+// the operation failed. The operations after it keep skipped.
+func markTermBatchRolledBack(operations []api.TermBatchOperation, results []api.TermBatchResult, failed int, message string) {
+	for i := 0; i < failed; i++ {
+		results[i].Status = api.RolledBack
+		// 取り消した作成の ID は存在しないので返さない
+		if operations[i].Op == api.Create {
+			results[i].Id = nil
+		}
+	}
+	results[failed].Status = api.Error
+	results[failed].Id = operations[failed].Id
+	results[failed].Message = &message
+}
+
+// batchTermsBestEffort commits each operation on its own and keeps going
+// after failures.
+func (h *TermHandler) batchTermsBestEffort(userId models.TermUserId, operations []api.TermBatchOperation, results []api.TermBatchResult) api.BatchTermsResponseObject {
+	succeeded := 0
+	for i, operation := range operations {
+		var termId models.TermId
+		err := models.WithTx(h.DB, func(tx models.SQLExecutor) error {
+			var err error
+			termId, err = h.applyTermBatchOperation(tx, userId, operation)
+			return err
+		})
+		if err == nil {
+			results[i].Status = api.Ok
+			results[i].Id = util.Ptr(string(termId))
+			succeeded++
+			continue
+		}
+
+		message, ok := termBatchErrorMessage(err)
+		if ok {
+			slog.Warn("Term batch operation failed", "index", i, "err", err)
+		} else {
+			// 前の操作はコミット済みなので、500 にせず結果に残す
+			slog.Error("Failed to apply term batch operation", "index", i, "err", err)
+			message = "Internal server error"
+		}
+		results[i].Status = api.Error
+		results[i].Id = operation.Id
+		results[i].Message = &message
+	}
+
+	return api.BatchTerms200JSONResponse{
+		Committed: true,
+		Succeeded: succeeded,
+		Failed:    len(operations) - succeeded,
+		Results:   results,
+	}
+}
+
+// applyTermBatchOperation runs one operation with the same models functions as
+// the single term endpoints and returns the ID of the term.
+func (h *TermHandler) applyTermBatchOperation(tx models.SQLExecutor, userId models.TermUserId, operation api.TermBatchOperation) (models.TermId, error) {
+	if operation.Op == api.Create {
+		return h.createTermInBatch(tx, userId, operation)
+	}
+
+	if operation.Id == nil || *operation.Id == "" {
+		return "", errTermBatchIdRequired
+	}
+	current, err := findOwnTerm(tx, models.TermId(*operation.Id), userId)
+	if err != nil {
+		return "", err
+	}
+	term := current.Term
+	categoryIds := current.Content().CategoryIds
+
+	switch operation.Op {
+	case api.Update:
+		if operation.Name != nil {
+			term.Name = models.TermName(*operation.Name)
+		}
+		if operation.Description != nil {
+			term.Description = models.TermDescription(*operation.Description)
+		}
+		if operation.CategoryIds != nil {
+			categoryIds = toCategoryIds(*operation.CategoryIds)
+		}
+	case api.AddCategory, api.RemoveCategory:
+		if operation.CategoryIds == nil || len(*operation.CategoryIds) == 0 {
+			return "", errTermBatchCategoryIdsRequired
+		}
+		if operation.Op == api.AddCategory {
+			categoryIds = appendMissingCategoryIds(categoryIds, toCategoryIds(*operation.CategoryIds))
+		} else {
+			categoryIds = removeCategoryIds(categoryIds, toCategoryIds(*operation.CategoryIds))
+		}
+	case api.Delete:
+		return term.ID, term.Delete(tx)
+	default:
+		return "", errTermBatchUnknownOp
+	}
+
+	term.UpdatedAt = util.Ptr(time.Now())
+	if _, err := term.UpdateWithRevision(tx, categoryIds, models.UserId(userId), h.RevisionRetention); err != nil {
+		return "", err
+	}
+	if operation.Op != api.Update {
+		return term.ID, nil
+	}
+	if operation.Status != nil {
+		if err := term.ChangeStatus(tx, models.TermStatus(*operation.Status)); err != nil {
+			return "", err
+		}
+	}
+	// keep the current sources unless the client sent a new list
+	if operation.Sources != nil {
+		if err := models.ReplaceTermSources(tx, term.ID, toTermSources(*operation.Sources)); err != nil {
+			return "", err
+		}
+	}
+	return term.ID, nil
+}
+
+func (h *TermHandler) createTermInBatch(tx models.SQLExecutor, userId models.TermUserId, operation api.TermBatchOperation) (models.TermId, error) {
+	var name models.TermName
+	if operation.Name != nil {
+		name = models.TermName(*operation.Name)
+	}
+	var description models.TermDescription
+	if operation.Description != nil {
+		description = models.TermDescription(*operation.Description)
+	}
+	var categoryIds []models.CategoryId
+	if operation.CategoryIds != nil {
+		categoryIds = toCategoryIds(*operation.CategoryIds)
+	}
+
+	created, err := models.CreateTerm(tx, userId, name, description, categoryIds)
+	if err != nil {
+		return "", err
+	}
+
+	// 新しい用語は unread なので、それ以外のときだけ変更として残す
+	if operation.Status != nil && models.TermStatus(*operation.Status) != models.TermStatusUnread {
+		if err := created.ChangeStatus(tx, models.TermStatus(*operation.Status)); err != nil {
+			return "", err
+		}
+	}
+	if operation.Sources != nil {
+		if err := models.ReplaceTermSources(tx, created.ID, toTermSources(*operation.Sources)); err != nil {
+			return "", err
+		}
+	}
+	return created.ID, nil
+}
+
+// termBatchErrorMessage returns the message for the client when err is caused
+// by the operation rather than by the server.
+func termBatchErrorMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, errNotFound):
+		return "Term not found", true
+	case errors.Is(err, errForbidden):
+		return "Forbidden", true
+	case errors.Is(err, errTermBatchIdRequired):
+		return "Term id is required", true
+	case errors.Is(err, errTermBatchCategoryIdsRequired):
+		return "Category ids are required", true
+	case errors.Is(err, errTermBatchUnknownOp):
+		return "Unknown operation", true
+	case errors.Is(err, models.ErrTermNameRequired):
+		return "Term name must not be empty", true
+	case errors.Is(err, models.ErrCategoryNotOwned):
+		return "Invalid category ids", true
+	case errors.Is(err, models.ErrInvalidTermStatus):
+		return "Invalid term status", true
+	default:
+		return sourceErrorMessage(err)
+	}
+}
+
+func toCategoryIds(ids []string) []models.CategoryId {
+	categoryIds := make([]models.CategoryId, len(ids))
+	for i, id := range ids {
+		categoryIds[i] = models.CategoryId(id)
+	}
+	return categoryIds
+}
+
+// ids にないものだけを後ろに追加する
+func appendMissingCategoryIds(ids []models.CategoryId, added []models.CategoryId) []models.CategoryId {
+	exists := make(map[models.CategoryId]struct{}, len(ids)+len(added))
+	for _, id := range ids {
+		exists[id] = struct{}{}
+	}
+	for _, id := range added {
+		if _, ok := exists[id]; ok {
+			continue
+		}
+		exists[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func removeCategoryIds(ids []models.CategoryId, removed []models.CategoryId) []models.CategoryId {
+	remove := make(map[models.CategoryId]struct{}, len(removed))
+	for _, id := range removed {
+		remove[id] = struct{}{}
+	}
+	kept := []models.CategoryId{}
+	for _, id := range ids {
+		if _, ok := remove[id]; !ok {
+			kept = append(kept, id)
+		}
+	}
+	return kept
+}
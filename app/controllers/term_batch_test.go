@@ -0,0 +1,185 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/takuchi17/term-keeper/api"
+	"github.com/takuchi17/term-keeper/app/models"
+	"github.com/takuchi17/term-keeper/pkg/util"
+)
+
+func TestAppendMissingCategoryIds(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ids      []models.CategoryId
+		added    []models.CategoryId
+		expected []models.CategoryId
+	}{
+		{
+			name:     "New ids are appended in order",
+			ids:      []models.CategoryId{"C1"},
+			added:    []models.CategoryId{"C3", "C2"},
+			expected: []models.CategoryId{"C1", "C3", "C2"},
+		},
+		{
+			name:     "Existing ids are not duplicated",
+			ids:      []models.CategoryId{"C1", "C2"},
+			added:    []models.CategoryId{"C2", "C3"},
+			expected: []models.CategoryId{"C1", "C2", "C3"},
+		},
+		{
+			name:     "Duplicated ids in added are appended once",
+			ids:      []models.CategoryId{},
+			added:    []models.CategoryId{"C1", "C1"},
+			expected: []models.CategoryId{"C1"},
+		},
+		{
+			name:     "Nothing to add",
+			ids:      []models.CategoryId{"C1"},
+			added:    []models.CategoryId{},
+			expected: []models.CategoryId{"C1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, appendMissingCategoryIds(tc.ids, tc.added))
+		})
+	}
+}
+
+func TestRemoveCategoryIds(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ids      []models.CategoryId
+		removed  []models.CategoryId
+		expected []models.CategoryId
+	}{
+		{
+			name:     "Removed ids are dropped and the order is kept",
+			ids:      []models.CategoryId{"C1", "C2", "C3"},
+			removed:  []models.CategoryId{"C2"},
+			expected: []models.CategoryId{"C1", "C3"},
+		},
+		{
+			name:     "Ids the term does not have are ignored",
+			ids:      []models.CategoryId{"C1"},
+			removed:  []models.CategoryId{"C9"},
+			expected: []models.CategoryId{"C1"},
+		},
+		{
+			name:     "Removing every id leaves an empty slice",
+			ids:      []models.CategoryId{"C1", "C2"},
+			removed:  []models.CategoryId{"C2", "C1"},
+			expected: []models.CategoryId{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, removeCategoryIds(tc.ids, tc.removed))
+		})
+	}
+}
+
+func TestMarkTermBatchRolledBack(t *testing.T) {
+	operations := []api.TermBatchOperation{
+		{Op: api.Create, Name: util.Ptr("Go")},
+		{Op: api.Update, Id: util.Ptr("TERM001"), Name: util.Ptr("SQL")},
+		{Op: api.Delete, Id: util.Ptr("TERM002")},
+		{Op: api.Delete, Id: util.Ptr("TERM003")},
+	}
+	results := []api.TermBatchResult{
+		{Index: 0, Op: string(api.Create), Status: api.Ok, Id: util.Ptr("CREATED")},
+		{Index: 1, Op: string(api.Update), Status: api.Ok, Id: util.Ptr("TERM001")},
+		{Index: 2, Op: string(api.Delete), Status: api.Skipped},
+		{Index: 3, Op: string(api.Delete), Status: api.Skipped},
+	}
+
+	markTermBatchRolledBack(operations, results, 2, "Term not found")
+
+	assert.Equal(t, api.RolledBack, results[0].Status)
+	assert.Nil(t, results[0].Id, "ID of a rolled back create should not be returned")
+	assert.Equal(t, api.RolledBack, results[1].Status)
+	assert.Equal(t, util.Ptr("TERM001"), results[1].Id)
+	assert.Equal(t, api.Error, results[2].Status)
+	assert.Equal(t, util.Ptr("TERM002"), results[2].Id)
+	assert.Equal(t, util.Ptr("Term not found"), results[2].Message)
+	assert.Equal(t, api.Skipped, results[3].Status, "Operations after the failure should be skipped")
+	assert.Nil(t, results[3].Message)
+}
+
+func TestTermBatchErrorMessage(t *testing.T) {
+	testCases := []struct {
+		name       string
+		err        error
+		expected   string
+		expectedOk bool
+	}{
+		{
+			name:       "Empty term name",
+			err:        fmt.Errorf("failed to create term: %w", models.ErrTermNameRequired),
+			expected:   "Term name must not be empty",
+			expectedOk: true,
+		},
+		{
+			name:       "Category of another user",
+			err:        models.ErrCategoryNotOwned,
+			expected:   "Invalid category ids",
+			expectedOk: true,
+		},
+		{
+			name:       "Invalid status",
+			err:        models.ErrInvalidTermStatus,
+			expected:   "Invalid term status",
+			expectedOk: true,
+		},
+		{
+			name:       "Term not found",
+			err:        errNotFound,
+			expected:   "Term not found",
+			expectedOk: true,
+		},
+		{
+			name:       "Term of another user",
+			err:        errForbidden,
+			expected:   "Forbidden",
+			expectedOk: true,
+		},
+		{
+			name:       "Missing term id",
+			err:        errTermBatchIdRequired,
+			expected:   "Term id is required",
+			expectedOk: true,
+		},
+		{
+			name:       "Missing category ids",
+			err:        errTermBatchCategoryIdsRequired,
+			expected:   "Category ids are required",
+			expectedOk: true,
+		},
+		{
+			name:       "Invalid source url",
+			err:        models.ErrInvalidSourceURL,
+			expected:   "Invalid source url",
+			expectedOk: true,
+		},
+		{
+			name:       "Server error",
+			err:        errors.New("connection refused"),
+			expected:   "",
+			expectedOk: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			message, ok := termBatchErrorMessage(tc.err)
+			assert.Equal(t, tc.expected, message)
+			assert.Equal(t, tc.expectedOk, ok)
+		})
+	}
+}
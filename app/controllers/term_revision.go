@@ -0,0 +1,167 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/takuchi17/term-keeper/api"
+	"github.com/takuchi17/term-keeper/app/models"
+	"github.com/takuchi17/term-keeper/middleware"
+	"github.com/takuchi17/term-keeper/pkg/util"
+)
+
+func (h *TermHandler) GetTermRevisions(ctx context.Context, request api.GetTermRevisionsRequestObject) (api.GetTermRevisionsResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.GetTermRevisions401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	current, err := h.getOwnTerm(models.TermId(request.Id), models.TermUserId(userId))
+	switch {
+	case errors.Is(err, errNotFound):
+		return api.GetTermRevisions404JSONResponse{Message: "Term not found"}, nil
+	case errors.Is(err, errForbidden):
+		return api.GetTermRevisions403JSONResponse{Message: "Forbidden"}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	revisions, err := models.GetTermRevisions(h.DB, current.Term.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get term revisions: %w", err)
+	}
+
+	items := make([]api.TermRevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		items[i] = toTermRevisionResponse(revision)
+	}
+
+	return api.GetTermRevisions200JSONResponse{Items: items}, nil
+}
+
+func (h *TermHandler) GetTermRevisionDiff(ctx context.Context, request api.GetTermRevisionDiffRequestObject) (api.GetTermRevisionDiffResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.GetTermRevisionDiff401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	current, err := h.getOwnTerm(models.TermId(request.Id), models.TermUserId(userId))
+	switch {
+	case errors.Is(err, errNotFound):
+		return api.GetTermRevisionDiff404JSONResponse{Message: "Term not found"}, nil
+	case errors.Is(err, errForbidden):
+		return api.GetTermRevisionDiff403JSONResponse{Message: "Forbidden"}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	from, err := models.GetTermRevision(h.DB, current.Term.ID, request.Revision)
+	if errors.Is(err, models.ErrTermRevisionNotFound) {
+		slog.Warn("Term revision not found", "termId", current.Term.ID, "revision", request.Revision)
+		return api.GetTermRevisionDiff404JSONResponse{Message: "Revision not found"}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get term revision: %w", err)
+	}
+
+	// to がなければ今の用語と比べる
+	to := current.Content()
+	if request.Params.To != nil {
+		revision, err := models.GetTermRevision(h.DB, current.Term.ID, *request.Params.To)
+		if errors.Is(err, models.ErrTermRevisionNotFound) {
+			slog.Warn("Term revision not found", "termId", current.Term.ID, "revision", *request.Params.To)
+			return api.GetTermRevisionDiff404JSONResponse{Message: "Revision not found"}, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get term revision: %w", err)
+		}
+		to = revision.TermContent
+	}
+
+	diffs := models.DiffTermContents(from.TermContent, to)
+	changes := make([]api.TermFieldDiff, len(diffs))
+	for i, diff := range diffs {
+		changes[i] = toTermFieldDiff(diff)
+	}
+
+	return api.GetTermRevisionDiff200JSONResponse{
+		From:    from.Revision,
+		To:      request.Params.To,
+		Changes: changes,
+	}, nil
+}
+
+func (h *TermHandler) RestoreTermRevision(ctx context.Context, request api.RestoreTermRevisionRequestObject) (api.RestoreTermRevisionResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.RestoreTermRevision401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	termId := models.TermId(request.Id)
+	err := models.WithTx(h.DB, func(tx models.SQLExecutor) error {
+		current, err := findOwnTerm(tx, termId, models.TermUserId(userId))
+		if err != nil {
+			return err
+		}
+		_, err = current.Term.RestoreRevision(tx, request.Revision, models.UserId(userId), h.RevisionRetention)
+		return err
+	})
+	switch {
+	case errors.Is(err, errNotFound):
+		return api.RestoreTermRevision404JSONResponse{Message: "Term not found"}, nil
+	case errors.Is(err, errForbidden):
+		return api.RestoreTermRevision403JSONResponse{Message: "Forbidden"}, nil
+	case errors.Is(err, models.ErrTermRevisionNotFound):
+		slog.Warn("Term revision not found", "termId", termId, "revision", request.Revision)
+		return api.RestoreTermRevision404JSONResponse{Message: "Revision not found"}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to restore term revision: %w", err)
+	}
+
+	restored, err := models.GetTermWithCategoriesById(h.DB, termId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get restored term: %w", err)
+	}
+
+	return api.RestoreTermRevision200JSONResponse(toTermResponse(restored)), nil
+}
+
+func toTermRevisionResponse(revision *models.TermRevision) api.TermRevisionResponse {
+	categoryIds := make([]string, len(revision.CategoryIds))
+	for i, id := range revision.CategoryIds {
+		categoryIds[i] = string(id)
+	}
+	return api.TermRevisionResponse{
+		Revision:    revision.Revision,
+		Name:        string(revision.Name),
+		Description: string(revision.Description),
+		CategoryIds: categoryIds,
+		EditorId:    string(revision.FKUserId),
+		CreatedAt:   revision.CreatedAt,
+	}
+}
+
+func toTermFieldDiff(diff models.TermFieldDiff) api.TermFieldDiff {
+	response := api.TermFieldDiff{Field: api.TermFieldDiffField(diff.Field)}
+	if diff.Field == models.TermFieldCategoryIds {
+		added := make([]string, len(diff.Added))
+		for i, id := range diff.Added {
+			added[i] = string(id)
+		}
+		removed := make([]string, len(diff.Removed))
+		for i, id := range diff.Removed {
+			removed[i] = string(id)
+		}
+		response.Added = &added
+		response.Removed = &removed
+		return response
+	}
+	response.Before = util.Ptr(diff.Before)
+	response.After = util.Ptr(diff.After)
+	return response
+}
@@ -0,0 +1,231 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/takuchi17/term-keeper/api"
+	"github.com/takuchi17/term-keeper/app/models"
+	"github.com/takuchi17/term-keeper/middleware"
+	"github.com/takuchi17/term-keeper/pkg/util"
+)
+
+type TrashHandler struct {
+	DB models.SQLExecutor
+	// ゴミ箱に残す期間。0 なら削除しない
+	Retention time.Duration
+}
+
+func (h *TrashHandler) GetTrash(ctx context.Context, request api.GetTrashRequestObject) (api.GetTrashResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.GetTrash401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	trash, err := models.GetTrashByUserId(h.DB, models.UserId(userId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trash: %w", err)
+	}
+
+	terms := make([]api.TrashedTermResponse, len(trash.Terms))
+	for i, trashed := range trash.Terms {
+		terms[i] = api.TrashedTermResponse{
+			Id:          string(trashed.Term.ID),
+			Name:        string(trashed.Term.Name),
+			Description: util.Ptr(string(trashed.Term.Description)),
+			DeletedAt:   trashed.DeletedAt,
+			PurgeAt:     h.purgeAt(trashed.DeletedAt),
+		}
+	}
+	categories := make([]api.TrashedCategoryResponse, len(trash.Categories))
+	for i, trashed := range trash.Categories {
+		categories[i] = api.TrashedCategoryResponse{
+			Id:        string(trashed.Category.ID),
+			Name:      string(trashed.Category.Name),
+			DeletedAt: trashed.DeletedAt,
+			PurgeAt:   h.purgeAt(trashed.DeletedAt),
+		}
+		// 色が未設定のときは返さない
+		if trashed.Category.HexColorCode != "" {
+			categories[i].HexColorCode = util.Ptr(string(trashed.Category.HexColorCode))
+		}
+	}
+
+	return api.GetTrash200JSONResponse{Terms: terms, Categories: categories}, nil
+}
+
+func (h *TrashHandler) RestoreTrashedTerm(ctx context.Context, request api.RestoreTrashedTermRequestObject) (api.RestoreTrashedTermResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.RestoreTrashedTerm401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	trashed, err := h.getOwnTrashedTerm(models.TermId(request.Id), models.TermUserId(userId))
+	switch {
+	case errors.Is(err, errNotFound):
+		return api.RestoreTrashedTerm404JSONResponse{Message: "Term not found in trash"}, nil
+	case errors.Is(err, errForbidden):
+		return api.RestoreTrashedTerm403JSONResponse{Message: "Forbidden"}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	err = trashed.Restore(h.DB)
+	if errors.Is(err, models.ErrNotInTrash) {
+		slog.Warn("Term left the trash meanwhile", "termId", request.Id)
+		return api.RestoreTrashedTerm404JSONResponse{Message: "Term not found in trash"}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore term: %w", err)
+	}
+
+	restored, err := models.GetTermWithCategoriesById(h.DB, trashed.Term.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get restored term: %w", err)
+	}
+
+	return api.RestoreTrashedTerm200JSONResponse(toTermResponse(restored)), nil
+}
+
+func (h *TrashHandler) PurgeTrashedTerm(ctx context.Context, request api.PurgeTrashedTermRequestObject) (api.PurgeTrashedTermResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.PurgeTrashedTerm401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	trashed, err := h.getOwnTrashedTerm(models.TermId(request.Id), models.TermUserId(userId))
+	switch {
+	case errors.Is(err, errNotFound):
+		return api.PurgeTrashedTerm404JSONResponse{Message: "Term not found in trash"}, nil
+	case errors.Is(err, errForbidden):
+		return api.PurgeTrashedTerm403JSONResponse{Message: "Forbidden"}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	err = trashed.Purge(h.DB)
+	if errors.Is(err, models.ErrNotInTrash) {
+		slog.Warn("Term left the trash meanwhile", "termId", request.Id)
+		return api.PurgeTrashedTerm404JSONResponse{Message: "Term not found in trash"}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to purge term: %w", err)
+	}
+
+	return api.PurgeTrashedTerm204Response{}, nil
+}
+
+func (h *TrashHandler) RestoreTrashedCategory(ctx context.Context, request api.RestoreTrashedCategoryRequestObject) (api.RestoreTrashedCategoryResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.RestoreTrashedCategory401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	trashed, err := h.getOwnTrashedCategory(models.CategoryId(request.Id), models.CategoryUserId(userId))
+	switch {
+	case errors.Is(err, errNotFound):
+		return api.RestoreTrashedCategory404JSONResponse{Message: "Category not found in trash"}, nil
+	case errors.Is(err, errForbidden):
+		return api.RestoreTrashedCategory403JSONResponse{Message: "Forbidden"}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	err = trashed.Restore(h.DB)
+	switch {
+	case errors.Is(err, models.ErrDuplicateCategoryName):
+		slog.Warn("Category name is used by another category", "categoryId", request.Id)
+		return api.RestoreTrashedCategory409JSONResponse{Message: "Category name already exists"}, nil
+	case errors.Is(err, models.ErrNotInTrash):
+		slog.Warn("Category left the trash meanwhile", "categoryId", request.Id)
+		return api.RestoreTrashedCategory404JSONResponse{Message: "Category not found in trash"}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to restore category: %w", err)
+	}
+
+	return api.RestoreTrashedCategory200JSONResponse(toCategoryResponse(trashed.Category)), nil
+}
+
+func (h *TrashHandler) PurgeTrashedCategory(ctx context.Context, request api.PurgeTrashedCategoryRequestObject) (api.PurgeTrashedCategoryResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.PurgeTrashedCategory401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	trashed, err := h.getOwnTrashedCategory(models.CategoryId(request.Id), models.CategoryUserId(userId))
+	switch {
+	case errors.Is(err, errNotFound):
+		return api.PurgeTrashedCategory404JSONResponse{Message: "Category not found in trash"}, nil
+	case errors.Is(err, errForbidden):
+		return api.PurgeTrashedCategory403JSONResponse{Message: "Forbidden"}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	err = trashed.Purge(h.DB)
+	if errors.Is(err, models.ErrNotInTrash) {
+		slog.Warn("Category left the trash meanwhile", "categoryId", request.Id)
+		return api.PurgeTrashedCategory404JSONResponse{Message: "Category not found in trash"}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to purge category: %w", err)
+	}
+
+	return api.PurgeTrashedCategory204Response{}, nil
+}
+
+// getOwnTrashedTerm returns errNotFound or errForbidden when the term is not
+// in the trash or belongs to another user.
+func (h *TrashHandler) getOwnTrashedTerm(termId models.TermId, userId models.TermUserId) (*models.TrashedTerm, error) {
+	trashed, err := models.GetTrashedTermById(h.DB, termId)
+	if errors.Is(err, models.ErrNotInTrash) {
+		slog.Warn("Term not found in trash", "termId", termId)
+		return nil, errNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trashed term: %w", err)
+	}
+
+	if trashed.Term.FKUserId != userId {
+		slog.Warn("Trashed term belongs to another user", "termId", termId, "userId", userId)
+		return nil, errForbidden
+	}
+
+	return trashed, nil
+}
+
+// getOwnTrashedCategory returns errNotFound or errForbidden when the category
+// is not in the trash or belongs to another user.
+func (h *TrashHandler) getOwnTrashedCategory(categoryId models.CategoryId, userId models.CategoryUserId) (*models.TrashedCategory, error) {
+	trashed, err := models.GetTrashedCategoryById(h.DB, categoryId)
+	if errors.Is(err, models.ErrNotInTrash) {
+		slog.Warn("Category not found in trash", "categoryId", categoryId)
+		return nil, errNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trashed category: %w", err)
+	}
+
+	if trashed.Category.FKUserId != userId {
+		slog.Warn("Trashed category belongs to another user", "categoryId", categoryId, "userId", userId)
+		return nil, errForbidden
+	}
+
+	return trashed, nil
+}
+
+// 期間が決まっていなければ nil
+func (h *TrashHandler) purgeAt(deletedAt time.Time) *time.Time {
+	if h.Retention <= 0 {
+		return nil
+	}
+	return util.Ptr(deletedAt.Add(h.Retention))
+}
@@ -0,0 +1,146 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/takuchi17/term-keeper/api"
+	"github.com/takuchi17/term-keeper/app/models"
+	"github.com/takuchi17/term-keeper/middleware"
+	"github.com/takuchi17/term-keeper/pkg/totp"
+)
+
+// 認証アプリに表示される発行者名
+const totpIssuer = "Term Keeper"
+
+type TwoFactorHandler struct {
+	DB models.SQLExecutor
+	// 再認証の失敗もログインの失敗として数える
+	LoginLimiter *LoginLimiter
+}
+
+func (h *TwoFactorHandler) GetTwoFactorStatus(ctx context.Context, request api.GetTwoFactorStatusRequestObject) (api.GetTwoFactorStatusResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.GetTwoFactorStatus401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	status, err := models.GetTwoFactorStatus(h.DB, models.UserId(userId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get two-factor status: %w", err)
+	}
+
+	return api.GetTwoFactorStatus200JSONResponse{
+		Enabled:                status.Enabled,
+		ConfirmedAt:            status.ConfirmedAt,
+		RecoveryCodesRemaining: status.RecoveryCodesRemaining,
+	}, nil
+}
+
+func (h *TwoFactorHandler) BeginTotpEnrollment(ctx context.Context, request api.BeginTotpEnrollmentRequestObject) (api.BeginTotpEnrollmentResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.BeginTotpEnrollment401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	user, err := models.GetUserById(h.DB, models.UserId(userId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by id: %w", err)
+	}
+
+	secret, err := models.BeginTOTPEnrollment(h.DB, user.ID)
+	if errors.Is(err, models.ErrTwoFactorAlreadyEnabled) {
+		return api.BeginTotpEnrollment409JSONResponse{Message: "Two-factor authentication is already enabled"}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin totp enrollment: %w", err)
+	}
+
+	return api.BeginTotpEnrollment200JSONResponse{
+		Secret:     secret,
+		OtpauthUri: totp.URI(totpIssuer, string(user.Email), secret),
+	}, nil
+}
+
+func (h *TwoFactorHandler) ConfirmTotpEnrollment(ctx context.Context, request api.ConfirmTotpEnrollmentRequestObject) (api.ConfirmTotpEnrollmentResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.ConfirmTotpEnrollment401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	recoveryCodes, err := models.ConfirmTOTPEnrollment(h.DB, models.UserId(userId), request.Body.Code)
+	switch {
+	case errors.Is(err, models.ErrInvalidTwoFactorCode):
+		return api.ConfirmTotpEnrollment400JSONResponse{Message: "Invalid code"}, nil
+	case errors.Is(err, models.ErrTwoFactorNotEnrolled):
+		return api.ConfirmTotpEnrollment400JSONResponse{Message: "Enrollment has not been started"}, nil
+	case errors.Is(err, models.ErrTwoFactorAlreadyEnabled):
+		return api.ConfirmTotpEnrollment409JSONResponse{Message: "Two-factor authentication is already enabled"}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to confirm totp enrollment: %w", err)
+	}
+
+	return api.ConfirmTotpEnrollment200JSONResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (h *TwoFactorHandler) DisableTwoFactor(ctx context.Context, request api.DisableTwoFactorRequestObject) (api.DisableTwoFactorResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.DisableTwoFactor401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	retryAfter, err := reauthenticate(ctx, h.DB, h.LoginLimiter, models.UserId(userId), request.Body.Password, request.Body.Code)
+	if retryAfter == 0 && err == nil {
+		err = models.DisableTwoFactor(h.DB, models.UserId(userId))
+	}
+	switch {
+	case retryAfter > 0:
+		return api.DisableTwoFactor429JSONResponse{
+			Body:    api.ErrorResponse{Message: "Too many failed attempts"},
+			Headers: api.DisableTwoFactor429ResponseHeaders{RetryAfter: retryAfterSeconds(retryAfter)},
+		}, nil
+	case errors.Is(err, errForbidden):
+		return api.DisableTwoFactor403JSONResponse{Message: "Invalid password or code"}, nil
+	case errors.Is(err, models.ErrTwoFactorNotEnabled):
+		return api.DisableTwoFactor400JSONResponse{Message: "Two-factor authentication is not enabled"}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to disable two-factor authentication: %w", err)
+	}
+
+	return api.DisableTwoFactor204Response{}, nil
+}
+
+func (h *TwoFactorHandler) RegenerateRecoveryCodes(ctx context.Context, request api.RegenerateRecoveryCodesRequestObject) (api.RegenerateRecoveryCodesResponseObject, error) {
+	userId, ok := middleware.GetUserID(ctx)
+	if !ok {
+		slog.Warn("Failed to get user ID from context")
+		return api.RegenerateRecoveryCodes401JSONResponse{Message: "Unauthorized"}, nil
+	}
+
+	var recoveryCodes []string
+	retryAfter, err := reauthenticate(ctx, h.DB, h.LoginLimiter, models.UserId(userId), request.Body.Password, request.Body.Code)
+	if retryAfter == 0 && err == nil {
+		recoveryCodes, err = models.RegenerateRecoveryCodes(h.DB, models.UserId(userId))
+	}
+	switch {
+	case retryAfter > 0:
+		return api.RegenerateRecoveryCodes429JSONResponse{
+			Body:    api.ErrorResponse{Message: "Too many failed attempts"},
+			Headers: api.RegenerateRecoveryCodes429ResponseHeaders{RetryAfter: retryAfterSeconds(retryAfter)},
+		}, nil
+	case errors.Is(err, errForbidden):
+		return api.RegenerateRecoveryCodes403JSONResponse{Message: "Invalid password or code"}, nil
+	case errors.Is(err, models.ErrTwoFactorNotEnabled):
+		return api.RegenerateRecoveryCodes400JSONResponse{Message: "Two-factor authentication is not enabled"}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to regenerate recovery codes: %w", err)
+	}
+
+	return api.RegenerateRecoveryCodes200JSONResponse{RecoveryCodes: recoveryCodes}, nil
+}
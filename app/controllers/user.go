@@ -1,76 +1,368 @@
 package controllers
 
 import (
-	"encoding/json"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/takuchi17/term-keeper/api"
 	"github.com/takuchi17/term-keeper/app/models"
-	"github.com/takuchi17/term-keeper/pkg/http_checker"
+	"github.com/takuchi17/term-keeper/middleware"
 	"github.com/takuchi17/term-keeper/pkg/jwt"
+	"github.com/takuchi17/term-keeper/pkg/mailer"
 )
 
 type UserHandeler struct {
-	DB models.SQLExecutor
+	DB         models.SQLExecutor
+	Mailer     mailer.Mailer
+	AppBaseURL string
+	// true ならメールアドレスを確認するまでログインさせない
+	RequireEmailVerification bool
+	LoginLimiter             *LoginLimiter
+	SessionCookie            SessionCookieOptions
 }
 
-func (h *UserHandeler) Create(w http.ResponseWriter, r *http.Request) {
-	var requestBody api.CreateUserJSONRequestBody
-	if err := http_checker.CheckRequest(w, r, &requestBody); err != nil {
-		slog.Warn("Failed to check request", "err", err)
-		return
-	}
-
-	err := models.CreateUser(
+func (h *UserHandeler) CreateUser(ctx context.Context, request api.CreateUserRequestObject) (api.CreateUserResponseObject, error) {
+	user, err := models.CreateUser(
 		h.DB,
-		models.UserName(requestBody.Username),
-		models.Email(requestBody.Email),
-		models.Password(requestBody.Password),
+		models.UserName(request.Body.Username),
+		models.Email(request.Body.Email),
+		models.Password(request.Body.Password),
 	)
 
+	if errors.Is(err, models.ErrEmailAlreadyUsed) {
+		return api.CreateUser409JSONResponse{Message: "Email is already used"}, nil
+	}
+	if violations := userValidationErrors(err); len(violations) > 0 {
+		return api.CreateUser400JSONResponse{Message: "Invalid user", Errors: &violations}, nil
+	}
 	if err != nil {
-		slog.Error("Failed to create user", "err", err)
-		errorResponse := api.ErrorResponse{Message: "Failed to create user"}
-		errorJSON, _ := json.Marshal(errorResponse)
-		w.Header().Set("Content-Type", "application/json")
-		http.Error(w, string(errorJSON), http.StatusInternalServerError)
-		return
+		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
-	w.WriteHeader(http.StatusCreated)
+	// 登録自体は済んでいるので、メールを送れなくても再送してもらえばよい
+	if err := h.sendVerificationMail(ctx, user); err != nil {
+		slog.Error("Failed to send verification mail", "userId", user.ID, "err", err)
+	}
+
+	return api.CreateUser201Response{}, nil
 }
 
-func (h *UserHandeler) Login(w http.ResponseWriter, r *http.Request) {
-	var requestBody api.UserLoginRequest
-	if err := http_checker.CheckRequest(w, r, &requestBody); err != nil {
-		slog.Warn("Failed to check request", "err", err)
-		return
+func (h *UserHandeler) LoginUser(ctx context.Context, request api.LoginUserRequestObject) (api.LoginUserResponseObject, error) {
+	email := models.Email(request.Body.Email)
+	ip, _ := middleware.GetClientIP(ctx)
+
+	// ロック中は bcrypt の比較もしない
+	retryAfter, err := h.LoginLimiter.allow(ctx, string(email), ip)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check login limit: %w", err)
+	}
+	if retryAfter > 0 {
+		slog.Warn("Login locked", "ip", ip, "retryAfter", retryAfter)
+		h.recordLoginAttempt(email, nil, ip, models.LoginResultLocked)
+		return api.LoginUser429JSONResponse{
+			Body:    api.ErrorResponse{Message: "Too many failed login attempts"},
+			Headers: api.LoginUser429ResponseHeaders{RetryAfter: retryAfterSeconds(retryAfter)},
+		}, nil
 	}
 
-	user, err := models.GetUserByEmail(h.DB, models.Email(requestBody.Email))
+	user, err := models.GetUserByEmail(h.DB, email)
+	if errors.Is(err, sql.ErrNoRows) {
+		slog.Warn("Login with unknown email")
+		if err := h.loginFailed(ctx, email, nil, ip); err != nil {
+			return nil, err
+		}
+		return api.LoginUser401JSONResponse{Message: "Invalid email or password"}, nil
+	}
 	if err != nil {
-		slog.Warn("Failed to get user by email", "err", err)
-		errorResponse := api.ErrorResponse{Message: "Failed to get user by email"}
-		errorJSON, _ := json.Marshal(errorResponse)
-		w.Header().Set("Content-Type", "application/json")
-		http.Error(w, string(errorJSON), http.StatusInternalServerError)
+		return nil, fmt.Errorf("failed to get user by email: %w", err)
 	}
 
-	if err := models.IsSamePassword(h.DB, user.Password, models.Password(requestBody.Password)); err != nil {
+	if err := models.IsSamePassword(h.DB, user.Password, models.Password(request.Body.Password)); err != nil {
 		slog.Warn("Failed to check password", "err", err)
-		errorResponse := api.ErrorResponse{Message: "Failed to check password"}
-		errorJSON, _ := json.Marshal(errorResponse)
-		w.Header().Set("Content-Type", "application/json")
-		http.Error(w, string(errorJSON), http.StatusInternalServerError)
-		return
+		if err := h.loginFailed(ctx, email, &user.ID, ip); err != nil {
+			return nil, err
+		}
+		return api.LoginUser401JSONResponse{Message: "Invalid email or password"}, nil
+	}
+
+	twoFactor, err := models.GetTwoFactorStatus(h.DB, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get two-factor status: %w", err)
+	}
+	// パスワードは正しいので失敗回数は消す。二段階認証ではコードを総当たりされないよう、コードが通るまで残す
+	if !twoFactor.Enabled {
+		if err := h.LoginLimiter.succeed(ctx, string(email)); err != nil {
+			return nil, fmt.Errorf("failed to reset login limit: %w", err)
+		}
 	}
 
+	if h.RequireEmailVerification && user.EmailVerifiedAt == nil {
+		slog.Warn("Login before email verification", "userId", user.ID)
+		h.recordLoginAttempt(email, &user.ID, ip, models.LoginResultUnverified)
+		return api.LoginUser403JSONResponse{Message: "Email address is not verified"}, nil
+	}
+
+	if twoFactor.Enabled {
+		challenge, err := models.IssueLoginChallenge(h.DB, user.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to issue login challenge: %w", err)
+		}
+		h.recordLoginAttempt(email, &user.ID, ip, models.LoginResultChallenged)
+		return api.LoginUser202JSONResponse{ChallengeToken: challenge, ExpiresIn: int(models.LoginChallengeTTL.Seconds())}, nil
+	}
+
+	h.recordLoginAttempt(email, &user.ID, ip, models.LoginResultSucceeded)
+
+	body, cookies, err := h.newSession(user, request.Body.SessionMode)
+	if err != nil {
+		return nil, err
+	}
+	if cookies == nil {
+		return api.LoginUser200JSONResponse(body), nil
+	}
+	return loginUserWithCookies{LoginUser200JSONResponse: api.LoginUser200JSONResponse(body), cookies: cookies}, nil
+}
+
+func (h *UserHandeler) LoginTwoFactor(ctx context.Context, request api.LoginTwoFactorRequestObject) (api.LoginTwoFactorResponseObject, error) {
+	ip, _ := middleware.GetClientIP(ctx)
+
+	userId, err := models.GetLoginChallengeUserId(h.DB, request.Body.ChallengeToken)
+	if errors.Is(err, models.ErrInvalidUserToken) {
+		slog.Warn("Invalid login challenge")
+		return api.LoginTwoFactor401JSONResponse{Message: "Invalid or expired challenge token"}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get login challenge: %w", err)
+	}
+	user, err := models.GetUserById(h.DB, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by id: %w", err)
+	}
+
+	retryAfter, err := h.LoginLimiter.allow(ctx, string(user.Email), ip)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check login limit: %w", err)
+	}
+	if retryAfter > 0 {
+		slog.Warn("Login locked", "ip", ip, "retryAfter", retryAfter)
+		h.recordLoginAttempt(user.Email, &user.ID, ip, models.LoginResultLocked)
+		return api.LoginTwoFactor429JSONResponse{
+			Body:    api.ErrorResponse{Message: "Too many failed login attempts"},
+			Headers: api.LoginTwoFactor429ResponseHeaders{RetryAfter: retryAfterSeconds(retryAfter)},
+		}, nil
+	}
+
+	_, err = models.CompleteLoginChallenge(h.DB, request.Body.ChallengeToken, request.Body.Code)
+	if errors.Is(err, models.ErrInvalidTwoFactorCode) {
+		slog.Warn("Invalid two-factor code", "userId", user.ID)
+		if err := h.loginFailed(ctx, user.Email, &user.ID, ip); err != nil {
+			return nil, err
+		}
+		return api.LoginTwoFactor401JSONResponse{Message: "Invalid code"}, nil
+	}
+	// 同時に使われたか、その間に二段階認証が無効にされた
+	if errors.Is(err, models.ErrInvalidUserToken) || errors.Is(err, models.ErrTwoFactorNotEnabled) {
+		slog.Warn("Failed to complete login challenge", "userId", user.ID, "err", err)
+		return api.LoginTwoFactor401JSONResponse{Message: "Invalid or expired challenge token"}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete login challenge: %w", err)
+	}
+
+	if err := h.LoginLimiter.succeed(ctx, string(user.Email)); err != nil {
+		return nil, fmt.Errorf("failed to reset login limit: %w", err)
+	}
+	h.recordLoginAttempt(user.Email, &user.ID, ip, models.LoginResultSucceeded)
+
+	body, cookies, err := h.newSession(user, request.Body.SessionMode)
+	if err != nil {
+		return nil, err
+	}
+	if cookies == nil {
+		return api.LoginTwoFactor200JSONResponse(body), nil
+	}
+	return loginTwoFactorWithCookies{LoginTwoFactor200JSONResponse: api.LoginTwoFactor200JSONResponse(body), cookies: cookies}, nil
+}
+
+// newSession issues the access token and the refresh token of a login. In the
+// cookie mode they are only returned as cookies and the body has the CSRF
+// token instead.
+func (h *UserHandeler) newSession(user *models.User, mode *api.SessionMode) (api.UserLoginResponse, []*http.Cookie, error) {
 	token, err := jwt.GenerateToken(user.ID, user.Name)
 	if err != nil {
-		http.Error(w, "Failed to generate token", http.StatusInternalServerError)
-		return
+		return api.UserLoginResponse{}, nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	refreshToken, err := models.IssueRefreshToken(h.DB, user.ID)
+	if err != nil {
+		return api.UserLoginResponse{}, nil, fmt.Errorf("failed to issue refresh token: %w", err)
+	}
+
+	expiresIn := int(jwt.AccessTokenTTL.Seconds())
+	if mode == nil || *mode != api.Cookie {
+		return api.UserLoginResponse{Token: &token, RefreshToken: &refreshToken, ExpiresIn: &expiresIn}, nil, nil
+	}
+
+	// ブラウザ向けにトークンは JavaScript から読めない Cookie にだけ入れる
+	csrfToken, err := newCSRFToken()
+	if err != nil {
+		return api.UserLoginResponse{}, nil, fmt.Errorf("failed to generate csrf token: %w", err)
+	}
+	return api.UserLoginResponse{ExpiresIn: &expiresIn, CsrfToken: &csrfToken}, h.SessionCookie.sessionCookies(token, refreshToken, csrfToken), nil
+}
+
+func (h *UserHandeler) loginFailed(ctx context.Context, email models.Email, userId *models.UserId, ip string) error {
+	h.recordLoginAttempt(email, userId, ip, models.LoginResultFailed)
+	if err := h.LoginLimiter.fail(ctx, string(email), ip); err != nil {
+		return fmt.Errorf("failed to record login failure: %w", err)
+	}
+	return nil
+}
+
+// 監査ログを残せなくてもログイン自体は止めない
+func (h *UserHandeler) recordLoginAttempt(email models.Email, userId *models.UserId, ip string, result models.LoginResult) {
+	err := models.RecordLoginAttempt(h.DB, &models.LoginAttempt{Email: email, FKUserId: userId, IPAddress: ip, Result: result})
+	if err != nil {
+		slog.Error("Failed to record login attempt", "result", result, "err", err)
 	}
+}
 
-	json.NewEncoder(w).Encode(api.UserLoginResponse{Token: &token})
+func (h *UserHandeler) VerifyEmail(ctx context.Context, request api.VerifyEmailRequestObject) (api.VerifyEmailResponseObject, error) {
+	_, err := models.VerifyEmail(h.DB, request.Body.Token)
+	// 登録時の確認でなければ、メールアドレスの変更の確認として試す
+	if errors.Is(err, models.ErrInvalidUserToken) {
+		_, err = models.ConfirmEmailChange(h.DB, request.Body.Token)
+	}
+	switch {
+	case errors.Is(err, models.ErrInvalidUserToken):
+		slog.Warn("Failed to verify email", "err", err)
+		return api.VerifyEmail400JSONResponse{Message: "Invalid or expired token"}, nil
+	case errors.Is(err, models.ErrEmailAlreadyUsed):
+		return api.VerifyEmail409JSONResponse{Message: "Email is already used"}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to verify email: %w", err)
+	}
+
+	return api.VerifyEmail204Response{}, nil
+}
+
+func (h *UserHandeler) ResendVerificationEmail(ctx context.Context, request api.ResendVerificationEmailRequestObject) (api.ResendVerificationEmailResponseObject, error) {
+	user, err := models.GetUserByEmail(h.DB, models.Email(request.Body.Email))
+	// 登録されているかどうかを返さない
+	if errors.Is(err, sql.ErrNoRows) {
+		slog.Warn("Resend verification mail to unknown email")
+		return api.ResendVerificationEmail202Response{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by email: %w", err)
+	}
+	if user.EmailVerifiedAt != nil {
+		return api.ResendVerificationEmail202Response{}, nil
+	}
+
+	if err := h.sendVerificationMail(ctx, user); err != nil {
+		slog.Error("Failed to send verification mail", "userId", user.ID, "err", err)
+	}
+
+	return api.ResendVerificationEmail202Response{}, nil
+}
+
+func (h *UserHandeler) ForgotPassword(ctx context.Context, request api.ForgotPasswordRequestObject) (api.ForgotPasswordResponseObject, error) {
+	user, err := models.GetUserByEmail(h.DB, models.Email(request.Body.Email))
+	// 登録されているかどうかを返さない
+	if errors.Is(err, sql.ErrNoRows) {
+		slog.Warn("Password reset for unknown email")
+		return api.ForgotPassword202Response{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by email: %w", err)
+	}
+
+	token, err := models.IssueUserToken(h.DB, user.ID, models.UserTokenPurposeResetPassword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue password reset token: %w", err)
+	}
+
+	err = h.Mailer.Send(ctx, mailer.Message{
+		To:      string(user.Email),
+		Subject: "Reset your Term Keeper password",
+		Body: "Open the link below within an hour to set a new password.\n\n" +
+			h.linkWithToken("/password/reset", token) + "\n\n" +
+			"If you did not ask for a password reset, you can ignore this mail.\n",
+	})
+	if err != nil {
+		slog.Error("Failed to send password reset mail", "userId", user.ID, "err", err)
+	}
+
+	return api.ForgotPassword202Response{}, nil
+}
+
+func (h *UserHandeler) ResetPassword(ctx context.Context, request api.ResetPasswordRequestObject) (api.ResetPasswordResponseObject, error) {
+	err := models.ResetPassword(h.DB, request.Body.Token, models.Password(request.Body.Password))
+	if errors.Is(err, models.ErrInvalidUserToken) {
+		slog.Warn("Failed to reset password", "err", err)
+		return api.ResetPassword400JSONResponse{Message: "Invalid or expired token"}, nil
+	}
+	if violations := userValidationErrors(err); len(violations) > 0 {
+		return api.ResetPassword400JSONResponse{Message: "Invalid password", Errors: &violations}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to reset password: %w", err)
+	}
+
+	return api.ResetPassword204Response{}, nil
+}
+
+func (h *UserHandeler) sendVerificationMail(ctx context.Context, user *models.User) error {
+	token, err := models.IssueUserToken(h.DB, user.ID, models.UserTokenPurposeVerifyEmail)
+	if err != nil {
+		return err
+	}
+
+	return h.Mailer.Send(ctx, mailer.Message{
+		To:      string(user.Email),
+		Subject: "Verify your Term Keeper email address",
+		Body: "Open the link below within 24 hours to verify your email address.\n\n" +
+			h.linkWithToken("/verify-email", token) + "\n",
+	})
+}
+
+// トークンはクエリに載せ、フロントエンドから API に送ってもらう
+func (h *UserHandeler) linkWithToken(path, token string) string {
+	return strings.TrimRight(h.AppBaseURL, "/") + path + "?token=" + url.QueryEscape(token)
+}
+
+// 入力の誤りとリクエストの項目の対応
+var userFieldErrors = []struct {
+	err     error
+	field   string
+	message string
+}{
+	{models.ErrUserNameRequired, "username", "Username is required"},
+	{models.ErrUserNameTooLong, "username", "Username must be at most 32 characters"},
+	{models.ErrUserNameInvalid, "username", "Username can contain only letters, digits, spaces, \"_\", \"-\" and \".\""},
+	{models.ErrEmailRequired, "email", "Email is required"},
+	{models.ErrEmailInvalid, "email", "Email is invalid"},
+	{models.ErrPasswordRequired, "password", "Password is required"},
+	{models.ErrPasswordTooShort, "password", "Password must be at least 8 characters"},
+	{models.ErrPasswordTooLong, "password", "Password must be at most 72 bytes"},
+	{models.ErrPasswordCommon, "password", "Password is too common"},
+}
+
+// userValidationErrors returns a violation for each invalid field joined in
+// err by the models, or nil when err is not about the input.
+func userValidationErrors(err error) []api.ValidationError {
+	var violations []api.ValidationError
+	for _, fieldErr := range userFieldErrors {
+		if errors.Is(err, fieldErr.err) {
+			violations = append(violations, api.ValidationError{In: "body", Field: fieldErr.field, Message: fieldErr.message})
+		}
+	}
+	return violations
 }
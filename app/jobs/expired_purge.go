@@ -0,0 +1,87 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/takuchi17/term-keeper/app/models"
+)
+
+// ExpiredPurger permanently deletes the rows that are only kept until they
+// expire, such as refresh tokens, the denylist of access tokens and the
+// tokens sent by mail, and the login attempts older than
+// LoginAttemptRetention.
+type ExpiredPurger struct {
+	DB models.SQLExecutor
+	// ログイン試行の記録を残す期間。0 なら削除しない
+	LoginAttemptRetention time.Duration
+	Interval              time.Duration
+	BatchSize             int
+	Now                   func() time.Time
+}
+
+func NewExpiredPurger(db models.SQLExecutor, loginAttemptRetention time.Duration, interval time.Duration) *ExpiredPurger {
+	return &ExpiredPurger{
+		DB:                    db,
+		LoginAttemptRetention: loginAttemptRetention,
+		Interval:              interval,
+		BatchSize:             defaultPurgeBatchSize,
+		Now:                   time.Now,
+	}
+}
+
+// Run purges the expired rows at once and then every Interval until ctx is
+// done. A failed purge is logged and tried again at the next interval.
+func (p *ExpiredPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+
+	for {
+		// 失敗しても次の周期でまとめて削除されるので止めない
+		_ = p.PurgeOnce()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeOnce deletes every row that expired before Now, and the login attempts
+// recorded before Now minus LoginAttemptRetention.
+func (p *ExpiredPurger) PurgeOnce() error {
+	now := p.Now()
+	refreshTokens, accessTokens, err := models.PurgeExpiredAuthTokens(p.DB, now, p.BatchSize)
+	if err != nil {
+		slog.Error("Failed to purge expired auth tokens", "err", err, "refreshTokens", refreshTokens, "accessTokens", accessTokens)
+		return err
+	}
+	if refreshTokens > 0 || accessTokens > 0 {
+		slog.Info("Purged expired auth tokens", "refreshTokens", refreshTokens, "accessTokens", accessTokens)
+	}
+
+	userTokens, err := models.PurgeExpiredUserTokens(p.DB, now, p.BatchSize)
+	if err != nil {
+		slog.Error("Failed to purge expired user tokens", "err", err, "userTokens", userTokens)
+		return err
+	}
+	if userTokens > 0 {
+		slog.Info("Purged expired user tokens", "userTokens", userTokens)
+	}
+
+	if p.LoginAttemptRetention <= 0 {
+		return nil
+	}
+	before := now.Add(-p.LoginAttemptRetention)
+	loginAttempts, err := models.PurgeLoginAttempts(p.DB, before, p.BatchSize)
+	if err != nil {
+		slog.Error("Failed to purge login attempts", "err", err, "loginAttempts", loginAttempts)
+		return err
+	}
+	if loginAttempts > 0 {
+		slog.Info("Purged login attempts", "loginAttempts", loginAttempts, "before", before)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/takuchi17/term-keeper/app/models"
+)
+
+// 一度の DELETE で削除する行数
+const defaultPurgeBatchSize = 500
+
+// TrashPurger permanently deletes the terms and categories that stayed in the
+// trash longer than Retention.
+type TrashPurger struct {
+	DB        models.SQLExecutor
+	Retention time.Duration
+	Interval  time.Duration
+	BatchSize int
+	Now       func() time.Time
+}
+
+func NewTrashPurger(db models.SQLExecutor, retention time.Duration, interval time.Duration) *TrashPurger {
+	return &TrashPurger{
+		DB:        db,
+		Retention: retention,
+		Interval:  interval,
+		BatchSize: defaultPurgeBatchSize,
+		Now:       time.Now,
+	}
+}
+
+// Run purges the trash at once and then every Interval until ctx is done.
+// A failed purge is logged and tried again at the next interval.
+func (p *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+
+	for {
+		// 失敗しても次の周期でまとめて削除されるので止めない
+		_ = p.PurgeOnce()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeOnce deletes every item trashed before Now minus Retention.
+func (p *TrashPurger) PurgeOnce() error {
+	before := p.Now().Add(-p.Retention)
+	terms, categories, err := models.PurgeTrash(p.DB, before, p.BatchSize)
+	if err != nil {
+		slog.Error("Failed to purge trash", "err", err, "terms", terms, "categories", categories)
+		return err
+	}
+	if terms > 0 || categories > 0 {
+		slog.Info("Purged trash", "terms", terms, "categories", categories, "before", before)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+const usage = "usage: migrate up | down [steps] | status"
+
+// RunCommand runs the migrate subcommand, e.g. `go run . migrate up`.
+func RunCommand(db *sql.DB, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(usage)
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := Up(db)
+		for _, m := range applied {
+			fmt.Fprintf(out, "applied  %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Fprintln(out, "no pending migrations")
+		}
+		return nil
+
+	case "down":
+		// 既定では一つだけ戻す
+		steps := 1
+		if len(args) > 1 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n < 1 {
+				return fmt.Errorf("invalid steps %q: %s", args[1], usage)
+			}
+			steps = n
+		}
+		reverted, err := Down(db, steps)
+		for _, m := range reverted {
+			fmt.Fprintf(out, "reverted %04d_%s\n", m.Version, m.Name)
+		}
+		return err
+
+	case "status":
+		statuses, err := GetStatus(db)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format(time.DateTime)
+			}
+			fmt.Fprintf(out, "%04d_%-40s %s\n", status.Migration.Version, status.Migration.Name, appliedAt)
+		}
+		return nil
+
+	default:
+		return errors.New(usage)
+	}
+}
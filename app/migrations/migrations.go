@@ -0,0 +1,292 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// マイグレーションは sql/<version>_<name>.up.sql と .down.sql の組で追加する
+//
+//go:embed sql/*.sql
+var files embed.FS
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+var ErrUnknownVersion = errors.New("applied migration is not known to this binary")
+
+const createSchemaMigrations = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+	version BIGINT NOT NULL,
+	name VARCHAR(255) NOT NULL,
+	applied_at DATETIME NOT NULL,
+	PRIMARY KEY(version)
+)
+`
+
+const getAppliedMigrations = `
+SELECT
+	version, applied_at
+FROM
+	schema_migrations
+ORDER BY
+	version ASC
+`
+
+const insertSchemaMigration = `
+INSERT INTO schema_migrations
+(
+	version,
+	name,
+	applied_at
+)
+VALUES
+(
+	?,
+	?,
+	?
+)
+`
+
+const deleteSchemaMigration = `
+DELETE
+FROM
+	schema_migrations
+WHERE
+	version = ?
+`
+
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type Status struct {
+	Migration *Migration
+	AppliedAt *time.Time
+}
+
+// Load returns the embedded migrations ordered by version.
+func Load() ([]*Migration, error) {
+	entries, err := fs.ReadDir(files, "sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		matches := fileNamePattern.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("invalid migration file name: %s", entry.Name())
+		}
+		version, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version: %s", entry.Name())
+		}
+		body, err := fs.ReadFile(files, "sql/"+entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = m
+		}
+		if m.Name != matches[2] {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, m.Name, matches[2])
+		}
+		if matches[3] == "up" {
+			m.Up = string(body)
+		} else {
+			m.Down = string(body)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both up and down files", m.Version, m.Name)
+		}
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+// Up applies every migration that has not been applied yet and returns them.
+func Up(db *sql.DB) ([]*Migration, error) {
+	statuses, err := GetStatus(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []*Migration
+	for _, status := range statuses {
+		if status.AppliedAt != nil {
+			continue
+		}
+		m := status.Migration
+		// MySQL の DDL は暗黙にコミットされるので、失敗したマイグレーションは手で戻す必要がある
+		if err := ExecScript(db, m.Up); err != nil {
+			slog.Error("Failed to apply migration", "version", m.Version, "name", m.Name, "err", err)
+			return applied, fmt.Errorf("failed to apply migration %d_%s: %w", m.Version, m.Name, err)
+		}
+		if _, err := db.Exec(insertSchemaMigration, m.Version, m.Name, time.Now()); err != nil {
+			slog.Error("Failed to record migration", "version", m.Version, "err", err)
+			return applied, err
+		}
+		slog.Info("Applied migration", "version", m.Version, "name", m.Name)
+		applied = append(applied, m)
+	}
+
+	return applied, nil
+}
+
+// Down reverts the latest steps applied migrations and returns them.
+func Down(db *sql.DB, steps int) ([]*Migration, error) {
+	statuses, err := GetStatus(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var reverted []*Migration
+	for i := len(statuses) - 1; i >= 0 && len(reverted) < steps; i-- {
+		if statuses[i].AppliedAt == nil {
+			continue
+		}
+		m := statuses[i].Migration
+		if err := ExecScript(db, m.Down); err != nil {
+			slog.Error("Failed to revert migration", "version", m.Version, "name", m.Name, "err", err)
+			return reverted, fmt.Errorf("failed to revert migration %d_%s: %w", m.Version, m.Name, err)
+		}
+		if _, err := db.Exec(deleteSchemaMigration, m.Version); err != nil {
+			slog.Error("Failed to delete migration record", "version", m.Version, "err", err)
+			return reverted, err
+		}
+		slog.Info("Reverted migration", "version", m.Version, "name", m.Name)
+		reverted = append(reverted, m)
+	}
+
+	return reverted, nil
+}
+
+// GetStatus returns every embedded migration with the time it was applied,
+// or nil for pending ones.
+func GetStatus(db *sql.DB) ([]*Status, error) {
+	migrations, err := Load()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := db.Exec(createSchemaMigrations); err != nil {
+		slog.Error("Failed to create schema_migrations", "err", err)
+		return nil, err
+	}
+
+	rows, err := db.Query(getAppliedMigrations)
+	if err != nil {
+		slog.Error("Failed to get applied migrations", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	appliedAt := map[int64]time.Time{}
+	for rows.Next() {
+		var version int64
+		var at time.Time
+		if err := rows.Scan(&version, &at); err != nil {
+			slog.Error("Failed to scan applied migration", "err", err)
+			return nil, err
+		}
+		appliedAt[version] = at
+	}
+
+	statuses := make([]*Status, len(migrations))
+	for i, m := range migrations {
+		statuses[i] = &Status{Migration: m}
+		if at, ok := appliedAt[m.Version]; ok {
+			statuses[i].AppliedAt = &at
+			delete(appliedAt, m.Version)
+		}
+	}
+	// 新しいバイナリで適用されたマイグレーションを古いバイナリで扱わない
+	for version := range appliedAt {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+	}
+
+	return statuses, nil
+}
+
+// ExecScript runs every statement of a SQL script in order on one connection,
+// so user variables and prepared statements are kept between statements.
+// Statements are separated by ";" outside of quotes, and "--" comments are skipped.
+func ExecScript(db *sql.DB, script string) error {
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	for _, statement := range splitStatements(script) {
+		if _, err := conn.ExecContext(ctx, statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func splitStatements(script string) []string {
+	var statements []string
+	var sb strings.Builder
+	var quote rune
+
+	runes := []rune(script)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote != 0:
+			sb.WriteRune(r)
+			if r == '\\' && i+1 < len(runes) {
+				i++
+				sb.WriteRune(runes[i])
+			} else if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+			sb.WriteRune(r)
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			// 行末までコメント
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			sb.WriteRune('\n')
+		case r == ';':
+			if statement := strings.TrimSpace(sb.String()); statement != "" {
+				statements = append(statements, statement)
+			}
+			sb.Reset()
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	if statement := strings.TrimSpace(sb.String()); statement != "" {
+		statements = append(statements, statement)
+	}
+
+	return statements
+}
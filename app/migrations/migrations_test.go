@@ -0,0 +1,63 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	migrations, err := Load()
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+
+	for i, m := range migrations {
+		assert.NotEmpty(t, m.Name, "Migration name should not be empty")
+		assert.NotEmpty(t, m.Up, "Up script should not be empty")
+		assert.NotEmpty(t, m.Down, "Down script should not be empty")
+		if i > 0 {
+			assert.Greater(t, m.Version, migrations[i-1].Version, "Migrations should be ordered by version")
+		}
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	testCases := []struct {
+		name     string
+		script   string
+		expected []string
+	}{
+		{
+			name:     "Multiple statements",
+			script:   "CREATE TABLE a (id INT);\n\nCREATE TABLE b (id INT);\n",
+			expected: []string{"CREATE TABLE a (id INT)", "CREATE TABLE b (id INT)"},
+		},
+		{
+			name:     "Comments are skipped",
+			script:   "-- comment;\nINSERT INTO a VALUES (1), -- one\n(2); -- two\n",
+			expected: []string{"INSERT INTO a VALUES (1), \n(2)"},
+		},
+		{
+			name:     "Semicolon and dashes in quotes",
+			script:   "INSERT INTO a VALUES ('x;y', 'it''s -- not a comment', 'a\\'b;');",
+			expected: []string{"INSERT INTO a VALUES ('x;y', 'it''s -- not a comment', 'a\\'b;')"},
+		},
+		{
+			name:     "Last statement without semicolon",
+			script:   "DROP TABLE a",
+			expected: []string{"DROP TABLE a"},
+		},
+		{
+			name:     "Empty script",
+			script:   "\n-- nothing\n",
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, splitStatements(tc.script))
+		})
+	}
+}
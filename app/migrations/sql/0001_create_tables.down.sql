@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS term_category_relations;
+DROP TABLE IF EXISTS terms;
+DROP TABLE IF EXISTS categories;
+DROP TABLE IF EXISTS users;
@@ -1,8 +1,3 @@
-CREATE DATABASE IF NOT EXISTS term_keeper_db;
-SET NAMES utf8mb4;
-USE term_keeper_db;
-
--- テーブル作成
 CREATE TABLE IF NOT EXISTS users (
       id CHAR(26) NOT NULL,
       name VARCHAR(32) NOT NULL,
@@ -21,6 +16,7 @@ CREATE TABLE IF NOT EXISTS categories (
       created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
       updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
       FOREIGN KEY (fk_user_id) REFERENCES users(id) ON DELETE CASCADE,
+      UNIQUE KEY uq_categories_user_name (fk_user_id, name),
       PRIMARY KEY(id)
 );
 
@@ -0,0 +1 @@
+ALTER TABLE terms DROP INDEX ft_terms_name_description;
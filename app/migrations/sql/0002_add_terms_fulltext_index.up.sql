@@ -0,0 +1,2 @@
+-- 日本語も検索できるように ngram パーサを使う
+ALTER TABLE terms ADD FULLTEXT INDEX ft_terms_name_description (name, description) WITH PARSER ngram;
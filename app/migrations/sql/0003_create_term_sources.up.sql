@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS term_sources (
+      id CHAR(26) NOT NULL,
+      fk_term_id CHAR(26) NOT NULL,
+      position INT NOT NULL,
+      url VARCHAR(2048) NOT NULL,
+      -- ドメインで絞り込むために正規化したホスト名を持つ
+      domain VARCHAR(255) NOT NULL,
+      title VARCHAR(255),
+      note VARCHAR(1000),
+      captured_at DATETIME,
+      created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+      FOREIGN KEY (fk_term_id) REFERENCES terms(id) ON DELETE CASCADE,
+      INDEX idx_term_sources_term_position (fk_term_id, position),
+      INDEX idx_term_sources_domain (domain),
+      PRIMARY KEY(id)
+);
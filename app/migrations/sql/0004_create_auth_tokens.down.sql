@@ -0,0 +1,3 @@
+ALTER TABLE users DROP COLUMN tokens_valid_after;
+DROP TABLE IF EXISTS revoked_access_tokens;
+DROP TABLE IF EXISTS refresh_tokens;
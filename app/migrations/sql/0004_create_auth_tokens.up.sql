@@ -0,0 +1,28 @@
+-- リフレッシュトークンはハッシュだけを保存する
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+      id CHAR(26) NOT NULL,
+      fk_user_id CHAR(26) NOT NULL,
+      -- ローテーションで発行されたトークンは同じ family_id を持つ
+      family_id CHAR(26) NOT NULL,
+      token_hash CHAR(64) NOT NULL,
+      expires_at DATETIME NOT NULL,
+      used_at DATETIME,
+      revoked_at DATETIME,
+      created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+      FOREIGN KEY (fk_user_id) REFERENCES users(id) ON DELETE CASCADE,
+      UNIQUE KEY uq_refresh_tokens_hash (token_hash),
+      INDEX idx_refresh_tokens_family (family_id),
+      PRIMARY KEY(id)
+);
+
+-- 有効期限前に無効にしたアクセストークンの jti
+CREATE TABLE IF NOT EXISTS revoked_access_tokens (
+      jti CHAR(26) NOT NULL,
+      expires_at DATETIME NOT NULL,
+      created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+      INDEX idx_revoked_access_tokens_expires (expires_at),
+      PRIMARY KEY(jti)
+);
+
+-- これより前に発行されたアクセストークンはすべて無効
+ALTER TABLE users ADD COLUMN tokens_valid_after DATETIME NULL;
@@ -0,0 +1,19 @@
+-- メール確認とパスワード再設定のトークン。ハッシュだけを保存し、一度使ったら無効になる
+CREATE TABLE IF NOT EXISTS user_tokens (
+      id CHAR(26) NOT NULL,
+      fk_user_id CHAR(26) NOT NULL,
+      purpose VARCHAR(32) NOT NULL,
+      token_hash CHAR(64) NOT NULL,
+      expires_at DATETIME NOT NULL,
+      used_at DATETIME,
+      created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+      FOREIGN KEY (fk_user_id) REFERENCES users(id) ON DELETE CASCADE,
+      UNIQUE KEY uq_user_tokens_hash (token_hash),
+      INDEX idx_user_tokens_user_purpose (fk_user_id, purpose),
+      PRIMARY KEY(id)
+);
+
+ALTER TABLE users ADD COLUMN email_verified_at DATETIME NULL;
+
+-- 既存のユーザーは確認済みとして扱う
+UPDATE users SET email_verified_at = created_at;
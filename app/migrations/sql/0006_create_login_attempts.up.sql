@@ -0,0 +1,23 @@
+-- 監査用にすべてのログイン試行を記録する
+CREATE TABLE IF NOT EXISTS login_attempts (
+      id CHAR(26) NOT NULL,
+      email VARCHAR(255) NOT NULL,
+      -- 存在しないメールアドレスなら NULL
+      fk_user_id CHAR(26),
+      ip_address VARCHAR(45) NOT NULL,
+      result VARCHAR(16) NOT NULL,
+      created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+      FOREIGN KEY (fk_user_id) REFERENCES users(id) ON DELETE SET NULL,
+      INDEX idx_login_attempts_email (email, created_at),
+      INDEX idx_login_attempts_ip (ip_address, created_at),
+      PRIMARY KEY(id)
+);
+
+-- 複数インスタンスで共有するログイン失敗回数 (ratelimit.Store)
+CREATE TABLE IF NOT EXISTS login_throttles (
+      throttle_key VARCHAR(320) NOT NULL,
+      failures INT NOT NULL DEFAULT 0,
+      last_failed_at DATETIME(3),
+      locked_until DATETIME(3),
+      PRIMARY KEY(throttle_key)
+);
@@ -0,0 +1,19 @@
+-- スクリプトや連携用の個人アクセストークン。ハッシュだけを保存する
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+      id CHAR(26) NOT NULL,
+      fk_user_id CHAR(26) NOT NULL,
+      name VARCHAR(100) NOT NULL,
+      token_hash CHAR(64) NOT NULL,
+      -- 一覧でどのトークンか見分けるための先頭部分
+      token_prefix VARCHAR(16) NOT NULL,
+      -- カンマ区切りのスコープ (terms:read など)
+      scopes VARCHAR(255) NOT NULL,
+      expires_at DATETIME,
+      last_used_at DATETIME,
+      revoked_at DATETIME,
+      created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+      FOREIGN KEY (fk_user_id) REFERENCES users(id) ON DELETE CASCADE,
+      UNIQUE KEY uq_personal_access_tokens_hash (token_hash),
+      INDEX idx_personal_access_tokens_user (fk_user_id, created_at),
+      PRIMARY KEY(id)
+);
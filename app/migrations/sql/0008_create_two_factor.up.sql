@@ -0,0 +1,23 @@
+-- TOTP の秘密鍵。confirmed_at が入るまでは登録の途中で、ログインには使わない
+CREATE TABLE IF NOT EXISTS user_totp (
+      fk_user_id CHAR(26) NOT NULL,
+      secret VARCHAR(64) NOT NULL,
+      confirmed_at DATETIME,
+      -- 同じコードを二度使わせないための最後に使ったステップ
+      last_used_step BIGINT NOT NULL DEFAULT 0,
+      created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+      FOREIGN KEY (fk_user_id) REFERENCES users(id) ON DELETE CASCADE,
+      PRIMARY KEY(fk_user_id)
+);
+
+-- 端末をなくしたときのリカバリーコード。ハッシュだけを保存し、一度使ったら無効になる
+CREATE TABLE IF NOT EXISTS user_recovery_codes (
+      id CHAR(26) NOT NULL,
+      fk_user_id CHAR(26) NOT NULL,
+      code_hash CHAR(64) NOT NULL,
+      used_at DATETIME,
+      created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+      FOREIGN KEY (fk_user_id) REFERENCES users(id) ON DELETE CASCADE,
+      INDEX idx_user_recovery_codes_user (fk_user_id, code_hash),
+      PRIMARY KEY(id)
+);
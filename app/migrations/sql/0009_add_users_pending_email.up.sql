@@ -0,0 +1,2 @@
+-- 変更を申し込んだメールアドレス。確認されるまで email は変えない
+ALTER TABLE users ADD COLUMN pending_email VARCHAR(255) NULL;
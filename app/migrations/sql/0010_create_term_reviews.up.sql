@@ -0,0 +1,32 @@
+-- 用語ごとの復習の予定。一度も復習していない用語には行がなく、すぐに復習の対象になる
+CREATE TABLE IF NOT EXISTS term_reviews (
+      fk_term_id CHAR(26) NOT NULL,
+      fk_user_id CHAR(26) NOT NULL,
+      ease DECIMAL(4, 2) NOT NULL,
+      interval_days INT NOT NULL,
+      repetitions INT NOT NULL,
+      lapses INT NOT NULL,
+      due_at DATETIME NOT NULL,
+      last_reviewed_at DATETIME NOT NULL,
+      FOREIGN KEY (fk_term_id) REFERENCES terms(id) ON DELETE CASCADE,
+      FOREIGN KEY (fk_user_id) REFERENCES users(id) ON DELETE CASCADE,
+      INDEX idx_term_reviews_user_due (fk_user_id, due_at),
+      PRIMARY KEY(fk_term_id)
+);
+
+-- 復習の履歴。回答と、それで決まった次の予定を残す
+CREATE TABLE IF NOT EXISTS review_logs (
+      id CHAR(26) NOT NULL,
+      fk_user_id CHAR(26) NOT NULL,
+      fk_term_id CHAR(26) NOT NULL,
+      grade VARCHAR(8) NOT NULL,
+      ease DECIMAL(4, 2) NOT NULL,
+      interval_days INT NOT NULL,
+      due_at DATETIME NOT NULL,
+      reviewed_at DATETIME NOT NULL,
+      FOREIGN KEY (fk_user_id) REFERENCES users(id) ON DELETE CASCADE,
+      FOREIGN KEY (fk_term_id) REFERENCES terms(id) ON DELETE CASCADE,
+      INDEX idx_review_logs_user_reviewed (fk_user_id, reviewed_at),
+      INDEX idx_review_logs_term_reviewed (fk_term_id, reviewed_at),
+      PRIMARY KEY(id)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS term_status_transitions;
+ALTER TABLE terms DROP INDEX idx_terms_user_status, DROP COLUMN status_changed_at, DROP COLUMN status;
@@ -0,0 +1,20 @@
+-- 用語の理解度。これまでは説明が空かどうかで確認済みかを判断していた
+ALTER TABLE terms
+      ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'unread',
+      ADD COLUMN status_changed_at DATETIME NULL,
+      ADD INDEX idx_terms_user_status (fk_user_id, status);
+
+-- 説明のある用語は確認済みだったものとして understood にする
+UPDATE terms SET status = 'understood', status_changed_at = updated_at WHERE description IS NOT NULL AND description != '';
+
+-- 理解度の変更履歴
+CREATE TABLE IF NOT EXISTS term_status_transitions (
+      id CHAR(26) NOT NULL,
+      fk_term_id CHAR(26) NOT NULL,
+      from_status VARCHAR(16) NOT NULL,
+      to_status VARCHAR(16) NOT NULL,
+      changed_at DATETIME NOT NULL,
+      FOREIGN KEY (fk_term_id) REFERENCES terms(id) ON DELETE CASCADE,
+      INDEX idx_term_status_transitions_term_changed (fk_term_id, changed_at),
+      PRIMARY KEY(id)
+);
@@ -0,0 +1,17 @@
+-- 用語を更新する前の内容。更新のたびに追加し、書き換えない
+CREATE TABLE IF NOT EXISTS term_revisions (
+      id CHAR(26) NOT NULL,
+      fk_term_id CHAR(26) NOT NULL,
+      revision INT NOT NULL,
+      fk_user_id CHAR(26) NOT NULL,
+      name VARCHAR(255) NOT NULL,
+      description VARCHAR(500) NULL,
+      -- 削除されたカテゴリの ID も残す
+      category_ids JSON NOT NULL,
+      created_at DATETIME NOT NULL,
+      FOREIGN KEY (fk_term_id) REFERENCES terms(id) ON DELETE CASCADE,
+      FOREIGN KEY (fk_user_id) REFERENCES users(id) ON DELETE CASCADE,
+      UNIQUE KEY uq_term_revisions_term_revision (fk_term_id, revision),
+      INDEX idx_term_revisions_created (created_at),
+      PRIMARY KEY(id)
+);
@@ -0,0 +1,2 @@
+-- 0001 で作られたキーと区別できないので残す。変えた名前も元に戻さない
+DO 0;
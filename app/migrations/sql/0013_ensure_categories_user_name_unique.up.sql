@@ -0,0 +1,26 @@
+-- マイグレーションを導入する前の init.sql で作ったデータベースには uq_categories_user_name がなく、
+-- 0001 は CREATE TABLE IF NOT EXISTS なので既存のテーブルには追加されない
+
+-- 重複した名前は最初に作ったカテゴリだけ残し、ほかは ID を付けた名前に変える
+UPDATE categories c
+JOIN (
+      SELECT id
+      FROM (
+            SELECT id, ROW_NUMBER() OVER (PARTITION BY fk_user_id, name ORDER BY created_at, id) AS n
+            FROM categories
+      ) ranked
+      WHERE n > 1
+) duplicated ON duplicated.id = c.id
+SET c.name = CONCAT(LEFT(c.name, 71), ' (', c.id, ')');
+
+SET @add_unique_key = IF(
+      EXISTS (
+            SELECT 1 FROM information_schema.statistics
+            WHERE table_schema = DATABASE() AND table_name = 'categories' AND index_name = 'uq_categories_user_name'
+      ),
+      'DO 0',
+      'ALTER TABLE categories ADD UNIQUE KEY uq_categories_user_name (fk_user_id, name)'
+);
+PREPARE add_unique_key FROM @add_unique_key;
+EXECUTE add_unique_key;
+DEALLOCATE PREPARE add_unique_key;
@@ -0,0 +1,4 @@
+-- ゴミ箱の行は戻せないので削除する
+DELETE FROM terms WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE terms DROP INDEX idx_terms_deleted, DROP INDEX idx_terms_user_deleted, DROP COLUMN deleted_at;
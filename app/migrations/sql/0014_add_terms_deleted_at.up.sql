@@ -0,0 +1,6 @@
+-- ゴミ箱に入れた日時。NULL なら削除されていない
+-- 途中で失敗しても再実行できるように、0016 まで一つの文ずつに分けている
+ALTER TABLE terms
+      ADD COLUMN deleted_at DATETIME NULL,
+      ADD INDEX idx_terms_user_deleted (fk_user_id, deleted_at),
+      ADD INDEX idx_terms_deleted (deleted_at);
@@ -0,0 +1,4 @@
+-- ゴミ箱の行は戻せないので削除する
+DELETE FROM categories WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE categories DROP INDEX idx_categories_deleted, DROP COLUMN active, DROP COLUMN deleted_at;
@@ -0,0 +1,5 @@
+ALTER TABLE categories
+      ADD COLUMN deleted_at DATETIME NULL,
+      -- ゴミ箱のカテゴリは NULL になり、同じ名前のカテゴリを作れる
+      ADD COLUMN active TINYINT GENERATED ALWAYS AS (IF(deleted_at IS NULL, 1, NULL)) VIRTUAL,
+      ADD INDEX idx_categories_deleted (deleted_at);
@@ -0,0 +1,6 @@
+-- ゴミ箱のカテゴリは名前が重複しうるので、キーを戻す前に削除する
+DELETE FROM categories WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE categories
+      ADD UNIQUE KEY uq_categories_user_name (fk_user_id, name),
+      DROP INDEX uq_categories_user_name_active;
@@ -0,0 +1,4 @@
+-- uq_categories_user_name は 0001 か 0013 で作られている
+ALTER TABLE categories
+      ADD UNIQUE KEY uq_categories_user_name_active (fk_user_id, name, active),
+      DROP INDEX uq_categories_user_name;
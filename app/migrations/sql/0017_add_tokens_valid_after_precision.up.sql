@@ -0,0 +1,2 @@
+-- ログアウトの直前と直後に発行されたアクセストークンを区別できるように、iat_us と同じマイクロ秒単位にする
+ALTER TABLE users MODIFY COLUMN tokens_valid_after DATETIME(6) NULL;
@@ -0,0 +1 @@
+ALTER TABLE refresh_tokens DROP INDEX idx_refresh_tokens_expires;
@@ -0,0 +1,2 @@
+-- 期限切れのリフレッシュトークンを定期的に削除するため
+ALTER TABLE refresh_tokens ADD INDEX idx_refresh_tokens_expires (expires_at);
@@ -0,0 +1 @@
+ALTER TABLE user_tokens DROP INDEX idx_user_tokens_expires;
@@ -0,0 +1,2 @@
+-- 期限切れのトークンを定期的に削除するため
+ALTER TABLE user_tokens ADD INDEX idx_user_tokens_expires (expires_at);
@@ -0,0 +1 @@
+ALTER TABLE login_attempts DROP INDEX idx_login_attempts_created;
@@ -0,0 +1,2 @@
+-- 古いログイン試行の記録を定期的に削除するため
+ALTER TABLE login_attempts ADD INDEX idx_login_attempts_created (created_at);
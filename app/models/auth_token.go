@@ -0,0 +1,227 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	mathrand "math/rand"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/takuchi17/term-keeper/app/models/queries"
+)
+
+// RefreshTokenTTL is how long a refresh token can be exchanged for new tokens.
+const RefreshTokenTTL = 30 * 24 * time.Hour
+
+var (
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	// ErrRefreshTokenReused is returned when an already rotated refresh token
+	// is used again. The whole family has been revoked by then.
+	ErrRefreshTokenReused = errors.New("refresh token reused")
+)
+
+type RefreshToken struct {
+	ID        string
+	FKUserId  UserId
+	FamilyId  string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+}
+
+// IssueRefreshToken creates a refresh token of a new family for the user and
+// returns the plain token. Only its hash is stored.
+func IssueRefreshToken(db SQLExecutor, userId UserId) (string, error) {
+	return createRefreshToken(db, userId, newULID())
+}
+
+// RotateRefreshToken exchanges the plain refresh token for a new one of the
+// same family and returns it with the owner. Presenting a token that was
+// already rotated revokes the whole family and returns ErrRefreshTokenReused.
+func RotateRefreshToken(db SQLExecutor, plainToken string) (string, UserId, error) {
+	var (
+		newToken string
+		userId   UserId
+		reused   bool
+	)
+
+	err := WithTx(db, func(tx SQLExecutor) error {
+		token, err := getRefreshTokenForUpdate(tx, plainToken)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+
+		// 使用済みのトークンが再び使われたら盗まれた可能性があるので family ごと無効にする
+		if token.UsedAt != nil && token.RevokedAt == nil {
+			slog.Warn("Refresh token reused", "familyId", token.FamilyId, "userId", token.FKUserId)
+			if _, err := tx.Exec(queries.RevokeRefreshTokenFamily, now, token.FamilyId); err != nil {
+				slog.Error("Failed to revoke refresh token family", "err", err)
+				return err
+			}
+			reused = true
+			return nil
+		}
+		if token.RevokedAt != nil || token.UsedAt != nil || !now.Before(token.ExpiresAt) {
+			return ErrInvalidRefreshToken
+		}
+
+		if _, err := tx.Exec(queries.MarkRefreshTokenUsed, now, token.ID); err != nil {
+			slog.Error("Failed to mark refresh token used", "err", err)
+			return err
+		}
+		newToken, err = createRefreshToken(tx, token.FKUserId, token.FamilyId)
+		if err != nil {
+			return err
+		}
+		userId = token.FKUserId
+		return nil
+	})
+	if err != nil {
+		return "", "", err
+	}
+	// family の無効化はコミットしてからエラーを返す
+	if reused {
+		return "", "", ErrRefreshTokenReused
+	}
+
+	return newToken, userId, nil
+}
+
+// RevokeRefreshTokenFamily revokes the family of the plain refresh token if it
+// belongs to the user. Unknown tokens are ignored so logout always succeeds.
+func RevokeRefreshTokenFamily(db SQLExecutor, userId UserId, plainToken string) error {
+	return WithTx(db, func(tx SQLExecutor) error {
+		token, err := getRefreshTokenForUpdate(tx, plainToken)
+		if errors.Is(err, ErrInvalidRefreshToken) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if token.FKUserId != userId {
+			slog.Warn("Tried to revoke a refresh token of another user", "userId", userId)
+			return nil
+		}
+
+		if _, err := tx.Exec(queries.RevokeRefreshTokenFamily, time.Now(), token.FamilyId); err != nil {
+			slog.Error("Failed to revoke refresh token family", "err", err)
+			return err
+		}
+		return nil
+	})
+}
+
+// RevokeAllTokens revokes every refresh token of the user and every access
+// token issued until now.
+func RevokeAllTokens(db SQLExecutor, userId UserId, now time.Time) error {
+	// tokens_valid_after と iat_us はマイクロ秒単位なので揃える
+	now = now.Truncate(time.Microsecond)
+	return WithTx(db, func(tx SQLExecutor) error {
+		if _, err := tx.Exec(queries.RevokeRefreshTokensByUserId, now, userId); err != nil {
+			slog.Error("Failed to revoke refresh tokens", "err", err)
+			return err
+		}
+		if _, err := tx.Exec(queries.UpdateUserTokensValidAfter, now, userId); err != nil {
+			slog.Error("Failed to update tokens_valid_after", "err", err)
+			return err
+		}
+		return nil
+	})
+}
+
+// RevokeAccessToken puts the jti of an access token on the denylist until it
+// expires.
+func RevokeAccessToken(db SQLExecutor, jti string, expiresAt time.Time) error {
+	if _, err := db.Exec(queries.CreateRevokedAccessToken, jti, expiresAt); err != nil {
+		slog.Error("Failed to revoke access token", "err", err)
+		return err
+	}
+	return nil
+}
+
+// IsAccessTokenRevoked reports whether the access token was revoked, either by
+// its jti or because the user revoked every token issued after it.
+func IsAccessTokenRevoked(db SQLExecutor, jti string, userId UserId, issuedAt time.Time) (bool, error) {
+	var revoked bool
+	if err := db.QueryRow(queries.IsAccessTokenRevoked, jti, userId, issuedAt).Scan(&revoked); err != nil {
+		slog.Error("Failed to check access token revocation", "err", err)
+		return false, err
+	}
+	return revoked, nil
+}
+
+// PurgeExpiredAuthTokens deletes the refresh tokens and the revoked access
+// tokens that expired before the given time, batchSize rows at a time. They
+// are rejected by their expiry anyway. It returns the number of deleted rows
+// of each.
+func PurgeExpiredAuthTokens(db SQLExecutor, before time.Time, batchSize int) (int, int, error) {
+	refreshTokens, err := purgeInBatches(db, queries.PurgeRefreshTokensExpiredBefore, before, batchSize)
+	if err != nil {
+		slog.Error("Failed to purge expired refresh tokens", "err", err)
+		return refreshTokens, 0, err
+	}
+	accessTokens, err := purgeInBatches(db, queries.PurgeRevokedAccessTokensExpiredBefore, before, batchSize)
+	if err != nil {
+		slog.Error("Failed to purge expired revoked access tokens", "err", err)
+		return refreshTokens, accessTokens, err
+	}
+	return refreshTokens, accessTokens, nil
+}
+
+func createRefreshToken(db SQLExecutor, userId UserId, familyId string) (string, error) {
+	plainToken, err := generateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	_, err = db.Exec(queries.CreateRefreshToken, newULID(), userId, familyId, hashToken(plainToken), now.Add(RefreshTokenTTL), now)
+	if err != nil {
+		slog.Error("Failed to create refresh token", "err", err)
+		return "", err
+	}
+	return plainToken, nil
+}
+
+func getRefreshTokenForUpdate(db SQLExecutor, plainToken string) (*RefreshToken, error) {
+	var token RefreshToken
+	err := db.QueryRow(queries.GetRefreshTokenByHashForUpdate, hashToken(plainToken)).Scan(
+		&token.ID, &token.FKUserId, &token.FamilyId, &token.ExpiresAt, &token.UsedAt, &token.RevokedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInvalidRefreshToken
+	}
+	if err != nil {
+		slog.Error("Failed to get refresh token", "err", err)
+		return nil, err
+	}
+	return &token, nil
+}
+
+// 推測できない 32 バイトのトークンを base64url で返す
+func generateOpaqueToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		slog.Error("Failed to generate token", "err", err)
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// トークンは十分にランダムなので bcrypt ではなく SHA-256 で引けるようにする
+func hashToken(plainToken string) string {
+	sum := sha256.Sum256([]byte(plainToken))
+	return hex.EncodeToString(sum[:])
+}
+
+func newULID() string {
+	t := time.Now()
+	entropy := ulid.Monotonic(mathrand.New(mathrand.NewSource(t.UnixNano())), 0)
+	return ulid.MustNew(ulid.Timestamp(t), entropy).String()
+}
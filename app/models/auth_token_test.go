@@ -0,0 +1,190 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateRefreshToken(t *testing.T) {
+	const userId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	first, err := IssueRefreshToken(tx, userId)
+	require.NoError(t, err)
+
+	second, owner, err := RotateRefreshToken(tx, first)
+	require.NoError(t, err)
+	assert.Equal(t, userId, owner, "Owner mismatch")
+	assert.NotEqual(t, first, second, "Refresh token should be rotated")
+
+	// 使用済みのトークンをもう一度使うと family ごと無効になる
+	_, _, err = RotateRefreshToken(tx, first)
+	assert.ErrorIs(t, err, ErrRefreshTokenReused)
+
+	_, _, err = RotateRefreshToken(tx, second)
+	assert.ErrorIs(t, err, ErrInvalidRefreshToken, "Newer token of the family should be revoked")
+
+	_, _, err = RotateRefreshToken(tx, "unknown-token")
+	assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+}
+
+func TestRevokeRefreshTokenFamily(t *testing.T) {
+	const (
+		userId      = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+		otherUserId = UserId("01HGDJ5HXZD3K6WFYS9JU0A1XG")
+	)
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	token, err := IssueRefreshToken(tx, userId)
+	require.NoError(t, err)
+	otherToken, err := IssueRefreshToken(tx, userId)
+	require.NoError(t, err)
+
+	// 他のユーザーのトークンは無効にできない
+	err = RevokeRefreshTokenFamily(tx, otherUserId, token)
+	require.NoError(t, err)
+	err = RevokeRefreshTokenFamily(tx, userId, "unknown-token")
+	require.NoError(t, err)
+
+	err = RevokeRefreshTokenFamily(tx, userId, token)
+	require.NoError(t, err)
+
+	_, _, err = RotateRefreshToken(tx, token)
+	assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+	_, _, err = RotateRefreshToken(tx, otherToken)
+	assert.NoError(t, err, "Token of another login should be kept")
+}
+
+func TestRevokeAllTokens(t *testing.T) {
+	const (
+		userId      = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+		otherUserId = UserId("01HGDJ5HXZD3K6WFYS9JU0A1XG")
+	)
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	token, err := IssueRefreshToken(tx, userId)
+	require.NoError(t, err)
+	otherToken, err := IssueRefreshToken(tx, otherUserId)
+	require.NoError(t, err)
+
+	now := time.Now()
+	err = RevokeAllTokens(tx, userId, now)
+	require.NoError(t, err)
+
+	_, _, err = RotateRefreshToken(tx, token)
+	assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+	_, _, err = RotateRefreshToken(tx, otherToken)
+	assert.NoError(t, err, "Tokens of other users should be kept")
+
+	testCases := []struct {
+		name     string
+		userId   UserId
+		issuedAt time.Time
+		expected bool
+	}{
+		{
+			name:     "Issued before logout-all",
+			userId:   userId,
+			issuedAt: now.Add(-time.Minute),
+			expected: true,
+		},
+		{
+			name:     "Issued after logout-all",
+			userId:   userId,
+			issuedAt: now.Add(time.Minute),
+			expected: false,
+		},
+		{
+			name:     "Issued just before logout-all",
+			userId:   userId,
+			issuedAt: now.Add(-time.Millisecond),
+			expected: true,
+		},
+		{
+			name:     "Issued just after logout-all",
+			userId:   userId,
+			issuedAt: now.Add(time.Millisecond),
+			expected: false,
+		},
+		{
+			name:     "Another user",
+			userId:   otherUserId,
+			issuedAt: now.Add(-time.Minute),
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			revoked, err := IsAccessTokenRevoked(tx, "not-revoked-jti", tc.userId, tc.issuedAt)
+			assert.NoError(t, err, "Expected no error, but an error occurred.")
+			assert.Equal(t, tc.expected, revoked, "Revocation mismatch")
+		})
+	}
+}
+
+func TestRevokeAccessToken(t *testing.T) {
+	const userId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	expiresAt := time.Now().Add(time.Hour)
+	err = RevokeAccessToken(tx, "revoked-jti", expiresAt)
+	require.NoError(t, err)
+	// 二重にログアウトしてもエラーにしない
+	err = RevokeAccessToken(tx, "revoked-jti", expiresAt)
+	require.NoError(t, err)
+
+	revoked, err := IsAccessTokenRevoked(tx, "revoked-jti", userId, time.Now())
+	assert.NoError(t, err)
+	assert.True(t, revoked, "Token on the denylist should be revoked")
+
+	revoked, err = IsAccessTokenRevoked(tx, "other-jti", userId, time.Now())
+	assert.NoError(t, err)
+	assert.False(t, revoked, "Other tokens should not be revoked")
+}
+
+func TestPurgeExpiredAuthTokens(t *testing.T) {
+	const userId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	now := time.Now()
+	expired, err := IssueRefreshToken(tx, userId)
+	require.NoError(t, err)
+	_, err = tx.Exec(`UPDATE refresh_tokens SET expires_at = ? WHERE token_hash = ?`, now.Add(-time.Hour), hashToken(expired))
+	require.NoError(t, err)
+	valid, err := IssueRefreshToken(tx, userId)
+	require.NoError(t, err)
+
+	require.NoError(t, RevokeAccessToken(tx, "expired-jti", now.Add(-time.Minute)))
+	require.NoError(t, RevokeAccessToken(tx, "valid-jti", now.Add(time.Minute)))
+
+	refreshTokens, accessTokens, err := PurgeExpiredAuthTokens(tx, now, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, refreshTokens)
+	assert.Equal(t, 1, accessTokens)
+
+	_, _, err = RotateRefreshToken(tx, valid)
+	assert.NoError(t, err, "Refresh token before its expiry should be kept")
+
+	revoked, err := IsAccessTokenRevoked(tx, "valid-jti", userId, now)
+	require.NoError(t, err)
+	assert.True(t, revoked, "Revoked access token before its expiry should be kept")
+}
@@ -1,8 +1,17 @@
 package models
 
 import (
+	"database/sql"
+	"errors"
+	"log/slog"
+	"math/rand"
+	"regexp"
 	"strings"
 	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/oklog/ulid/v2"
+	"github.com/takuchi17/term-keeper/app/models/queries"
 )
 
 type (
@@ -21,10 +30,65 @@ type Category struct {
 	UpdatedAt    *time.Time
 }
 
+var (
+	ErrCategoryNameRequired  = errors.New("category name is required")
+	ErrDuplicateCategoryName = errors.New("category name already exists")
+	ErrInvalidHexColorCode   = errors.New("hex color code must be #RGB or #RRGGBB")
+	ErrCategoryNotOwned      = errors.New("category does not belong to the user")
+)
+
+// api/openapi.yaml の hex_color_code と同じパターン
+var hexColorCodePattern = regexp.MustCompile(`^#([0-9a-fA-F]{6}|[0-9a-fA-F]{3})$`)
+
+// mysql の duplicate entry エラー番号
+const mysqlErrDuplicateEntry = 1062
+
+func CreateCategory(db SQLExecutor, userId CategoryUserId, name CategoryName, hexColorCode CategoryHexColorCode) (*Category, error) {
+	// cheack required fields
+	if name == "" {
+		return nil, ErrCategoryNameRequired
+	}
+	if err := validateHexColorCode(hexColorCode); err != nil {
+		return nil, err
+	}
+
+	// 同じユーザー内でカテゴリ名は一意
+	isDuplicate, err := IsDuplicateCategoryName(db, userId, name, "")
+	if err != nil {
+		return nil, err
+	}
+	if isDuplicate {
+		return nil, ErrDuplicateCategoryName
+	}
+
+	// generate ulid for categoryId
+	t := time.Now()
+	entropy := ulid.Monotonic(rand.New(rand.NewSource(t.UnixNano())), 0)
+	categoryId := CategoryId(ulid.MustNew(ulid.Timestamp(t), entropy).String())
+
+	_, err = db.Exec(queries.CreateCategory, categoryId, userId, name, nullableHexColorCode(hexColorCode), t, t)
+	if err != nil {
+		if isDuplicateEntryError(err) {
+			return nil, ErrDuplicateCategoryName
+		}
+		slog.Error("Failed to create a category", "err", err)
+		return nil, err
+	}
+
+	return &Category{
+		ID:           categoryId,
+		Name:         name,
+		FKUserId:     userId,
+		HexColorCode: hexColorCode,
+		CreatedAt:    &t,
+		UpdatedAt:    &t,
+	}, nil
+}
+
 // 単体取得
 func GetCategoryById(db SQLExecutor, id CategoryId) (*Category, error) {
 	// DBからカテゴリを取得
-	row := db.QueryRow("SELECT id, name, fk_user_id, hex_color_code, created_at, updated_at FROM categories WHERE id = ?", id)
+	row := db.QueryRow(queries.GetCategoryById, id)
 	var category Category
 	err := row.Scan(&category.ID, &category.Name, &category.FKUserId, &category.HexColorCode, &category.CreatedAt, &category.UpdatedAt)
 	if err != nil {
@@ -38,11 +102,7 @@ func GetCategoriesByIds(db SQLExecutor, ids []CategoryId) ([]*Category, error) {
 		return []*Category{}, nil
 	}
 
-	// プレースホルダ作成 (?, ?, ...)
-	placeholders := strings.Repeat("?,", len(ids))
-	placeholders = strings.TrimRight(placeholders, ",")
-
-	query := "SELECT id, name, fk_user_id, hex_color_code, created_at, updated_at FROM categories WHERE id IN (" + placeholders + ")"
+	query := queries.GetCategoriesByIdsBase + "(" + placeholders(len(ids)) + ")"
 
 	// []string → []interface{}
 	args := make([]interface{}, len(ids))
@@ -56,6 +116,124 @@ func GetCategoriesByIds(db SQLExecutor, ids []CategoryId) ([]*Category, error) {
 	}
 	defer rows.Close()
 
+	return scanCategories(rows)
+}
+
+func GetCategoriesByUserId(db SQLExecutor, userId CategoryUserId) ([]*Category, error) {
+	rows, err := db.Query(queries.GetCategoriesByUserId, userId)
+	if err != nil {
+		slog.Error("Failed to get categories", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanCategories(rows)
+}
+
+// excludeId を指定すると、そのカテゴリ自身は重複判定から除外する (更新時に使う)
+func IsDuplicateCategoryName(db SQLExecutor, userId CategoryUserId, name CategoryName, excludeId CategoryId) (bool, error) {
+	var count int
+	err := db.QueryRow(queries.IsDuplicateCategoryName, userId, name, excludeId).Scan(&count)
+	if err != nil {
+		slog.Error("Failed to check duplicate of category name", "err", err)
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// すべてのカテゴリがユーザーのものでなければ ErrCategoryNotOwned を返す
+func CheckCategoriesOwnedByUser(db SQLExecutor, userId UserId, ids []CategoryId) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// 重複した ID を一つにまとめる
+	unique := make(map[CategoryId]struct{}, len(ids))
+	args := []interface{}{userId}
+	for _, id := range ids {
+		if _, ok := unique[id]; ok {
+			continue
+		}
+		unique[id] = struct{}{}
+		args = append(args, id)
+	}
+
+	query := queries.CountCategoriesByUserIdAndIdsBase + "(" + placeholders(len(unique)) + ")"
+
+	var count int
+	if err := db.QueryRow(query, args...).Scan(&count); err != nil {
+		slog.Error("Failed to check category owner", "err", err)
+		return err
+	}
+	if count != len(unique) {
+		return ErrCategoryNotOwned
+	}
+	return nil
+}
+
+func (c *Category) Update(db SQLExecutor) (*Category, error) {
+	if c.Name == "" {
+		return nil, ErrCategoryNameRequired
+	}
+	if err := validateHexColorCode(c.HexColorCode); err != nil {
+		return nil, err
+	}
+
+	isDuplicate, err := IsDuplicateCategoryName(db, c.FKUserId, c.Name, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	if isDuplicate {
+		return nil, ErrDuplicateCategoryName
+	}
+
+	_, err = db.Exec(queries.UpdateCategory, c.Name, nullableHexColorCode(c.HexColorCode), c.UpdatedAt, c.ID)
+	if err != nil {
+		if isDuplicateEntryError(err) {
+			return nil, ErrDuplicateCategoryName
+		}
+		slog.Error("Failed to update category", "err", err)
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Delete moves the category to the trash. The terms keep their link to it
+// until it is restored or purged.
+func (c *Category) Delete(db SQLExecutor) error {
+	if _, err := db.Exec(queries.DeleteCategory, time.Now(), c.ID); err != nil {
+		slog.Error("Failed to delete category", "err", err)
+		return err
+	}
+	return nil
+}
+
+// 色は任意項目なので空文字は許可する
+func validateHexColorCode(hexColorCode CategoryHexColorCode) error {
+	if hexColorCode == "" {
+		return nil
+	}
+	if !hexColorCodePattern.MatchString(string(hexColorCode)) {
+		return ErrInvalidHexColorCode
+	}
+	return nil
+}
+
+// 空文字は NULL として保存する
+func nullableHexColorCode(hexColorCode CategoryHexColorCode) interface{} {
+	if hexColorCode == "" {
+		return nil
+	}
+	return hexColorCode
+}
+
+func isDuplicateEntryError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
+
+func scanCategories(rows *sql.Rows) ([]*Category, error) {
 	var categories []*Category
 	for rows.Next() {
 		var category Category
@@ -64,6 +242,10 @@ func GetCategoriesByIds(db SQLExecutor, ids []CategoryId) ([]*Category, error) {
 		}
 		categories = append(categories, &category)
 	}
-
 	return categories, nil
 }
+
+// プレースホルダ作成 (?, ?, ...)
+func placeholders(n int) string {
+	return strings.TrimRight(strings.Repeat("?,", n), ",")
+}
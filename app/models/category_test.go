@@ -0,0 +1,290 @@
+package models
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takuchi17/term-keeper/pkg/util"
+)
+
+func TestCreateCategory(t *testing.T) {
+	testCases := []struct {
+		name         string
+		userId       CategoryUserId
+		categoryName CategoryName
+		hexColorCode CategoryHexColorCode
+		wantErr      error
+	}{
+		{
+			name:         "Normal category creation",
+			userId:       "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			categoryName: "フロントエンド",
+			hexColorCode: "#123ABC",
+		},
+		{
+			name:         "Short hex color code",
+			userId:       "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			categoryName: "インフラ",
+			hexColorCode: "#fff",
+		},
+		{
+			name:         "Without hex color code",
+			userId:       "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			categoryName: "その他",
+			hexColorCode: "",
+		},
+		{
+			name:         "Same name as another user's category",
+			userId:       "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			categoryName: "機械学習",
+			hexColorCode: "#D433FF",
+		},
+		{
+			name:         "Duplicate name for the same user",
+			userId:       "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			categoryName: "データベース",
+			hexColorCode: "#33A8FF",
+			wantErr:      ErrDuplicateCategoryName,
+		},
+		{
+			name:         "Invalid hex color code",
+			userId:       "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			categoryName: "不正な色",
+			hexColorCode: "red",
+			wantErr:      ErrInvalidHexColorCode,
+		},
+		{
+			name:         "Hex color code without hash",
+			userId:       "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			categoryName: "不正な色2",
+			hexColorCode: "FF5733",
+			wantErr:      ErrInvalidHexColorCode,
+		},
+		{
+			name:         "Empty category name",
+			userId:       "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			categoryName: "",
+			hexColorCode: "#FF5733",
+			wantErr:      ErrCategoryNameRequired,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+			category, err := CreateCategory(tx, tc.userId, tc.categoryName, tc.hexColorCode)
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr, "Unexpected error")
+				return
+			}
+
+			assert.NoError(t, err, "Expected no error, but an error occurred.")
+			assert.NotEmpty(t, category.ID, "Category ID should not be empty")
+
+			// Verify category in database
+			stored, err := GetCategoryById(tx, category.ID)
+			assert.NoError(t, err, "Failed to get created category from database")
+			assert.Equal(t, tc.userId, stored.FKUserId, "User ID mismatch in database")
+			assert.Equal(t, tc.categoryName, stored.Name, "Category name mismatch in database")
+			assert.Equal(t, tc.hexColorCode, stored.HexColorCode, "Hex color code mismatch in database")
+		})
+	}
+}
+
+func TestGetCategoriesByUserId(t *testing.T) {
+	testCases := []struct {
+		name          string
+		userId        CategoryUserId
+		expectedNames []string
+	}{
+		{
+			name:          "User with categories",
+			userId:        "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			expectedNames: []string{"プログラミング", "データベース", "ネットワーク"},
+		},
+		{
+			name:          "No categories for non-existent user",
+			userId:        "NONEXISTENTUSERID000000000",
+			expectedNames: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+			categories, err := GetCategoriesByUserId(tx, tc.userId)
+
+			assert.NoError(t, err, "Expected no error, but an error occurred.")
+
+			names := []string{}
+			for _, category := range categories {
+				assert.Equal(t, tc.userId, category.FKUserId, "Category of another user returned")
+				names = append(names, string(category.Name))
+			}
+			assert.ElementsMatch(t, tc.expectedNames, names, "Category names mismatch")
+		})
+	}
+}
+
+func TestCheckCategoriesOwnedByUser(t *testing.T) {
+	testCases := []struct {
+		name        string
+		userId      UserId
+		categoryIds []CategoryId
+		wantErr     bool
+	}{
+		{
+			name:        "Own categories",
+			userId:      "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			categoryIds: []CategoryId{"CATE001PROG000000000000001", "CATE002DBS0000000000000001"},
+			wantErr:     false,
+		},
+		{
+			name:        "Duplicated own category",
+			userId:      "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			categoryIds: []CategoryId{"CATE001PROG000000000000001", "CATE001PROG000000000000001"},
+			wantErr:     false,
+		},
+		{
+			name:        "No categories",
+			userId:      "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			categoryIds: []CategoryId{},
+			wantErr:     false,
+		},
+		{
+			name:        "Mixed with another user's category",
+			userId:      "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			categoryIds: []CategoryId{"CATE001PROG000000000000001", "CATE004ML00000000000000001"},
+			wantErr:     true,
+		},
+		{
+			name:        "Non-existent category",
+			userId:      "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			categoryIds: []CategoryId{"NONEXISTENTCATEGORY0000000"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+			err = CheckCategoriesOwnedByUser(tx, tc.userId, tc.categoryIds)
+
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrCategoryNotOwned, "Expected ErrCategoryNotOwned")
+				return
+			}
+			assert.NoError(t, err, "Expected no error, but an error occurred.")
+		})
+	}
+}
+
+func TestCategoryUpdate(t *testing.T) {
+	testCases := []struct {
+		name            string
+		categoryId      CategoryId
+		newName         CategoryName
+		newHexColorCode CategoryHexColorCode
+		wantErr         error
+	}{
+		{
+			name:            "Update name and color",
+			categoryId:      "CATE001PROG000000000000001",
+			newName:         "プログラミング言語",
+			newHexColorCode: "#000000",
+		},
+		{
+			name:            "Keep the same name",
+			categoryId:      "CATE002DBS0000000000000001",
+			newName:         "データベース",
+			newHexColorCode: "#abc",
+		},
+		{
+			name:            "Rename to another category of the same user",
+			categoryId:      "CATE003NET0000000000000001",
+			newName:         "データベース",
+			newHexColorCode: "#33FF57",
+			wantErr:         ErrDuplicateCategoryName,
+		},
+		{
+			name:            "Invalid hex color code",
+			categoryId:      "CATE003NET0000000000000001",
+			newName:         "ネットワーク",
+			newHexColorCode: "#GGGGGG",
+			wantErr:         ErrInvalidHexColorCode,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+			category, err := GetCategoryById(tx, tc.categoryId)
+			require.NoError(t, err, "Failed to get original category")
+
+			category.Name = tc.newName
+			category.HexColorCode = tc.newHexColorCode
+			category.UpdatedAt = util.Ptr(time.Now())
+
+			_, err = category.Update(tx)
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr, "Unexpected error")
+				return
+			}
+
+			assert.NoError(t, err, "Expected no error, but an error occurred.")
+
+			stored, err := GetCategoryById(tx, tc.categoryId)
+			assert.NoError(t, err, "Failed to get updated category from database")
+			assert.Equal(t, tc.newName, stored.Name, "Category name mismatch in database")
+			assert.Equal(t, tc.newHexColorCode, stored.HexColorCode, "Hex color code mismatch in database")
+		})
+	}
+}
+
+func TestCategoryDelete(t *testing.T) {
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	// プログラミング is linked to Docker
+	category, err := GetCategoryById(tx, "CATE001PROG000000000000001")
+	require.NoError(t, err, "Category should exist before deletion")
+
+	err = category.Delete(tx)
+	assert.NoError(t, err, "Expected no error, but an error occurred.")
+
+	_, err = GetCategoryById(tx, category.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows, "Deleted category should not be found")
+
+	var count int
+	err = tx.QueryRow(`SELECT COUNT(*) FROM categories WHERE id = ? AND deleted_at IS NOT NULL`, category.ID).Scan(&count)
+	assert.NoError(t, err, "Error counting categories")
+	assert.Equal(t, 1, count, "Category should be in the trash")
+
+	// the relations are kept for restoring but the term no longer shows the category
+	err = tx.QueryRow(`SELECT COUNT(*) FROM term_category_relations WHERE fk_category_id = ?`, category.ID).Scan(&count)
+	assert.NoError(t, err, "Error counting term category relations")
+	assert.NotZero(t, count, "Term category relations should be kept")
+
+	categoryIds, err := GetCategoryIdsByTermId(tx, "TERM003DOCK00000000000001")
+	assert.NoError(t, err)
+	assert.Empty(t, categoryIds, "Trashed category should not be linked")
+
+	// the term itself is kept
+	err = tx.QueryRow(`SELECT COUNT(*) FROM terms WHERE id = ?`, "TERM003DOCK00000000000001").Scan(&count)
+	assert.NoError(t, err, "Error counting terms")
+	assert.Equal(t, 1, count, "Linked term should not be deleted")
+}
@@ -4,8 +4,12 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"log/slog"
+	"math/rand"
+	"time"
 
 	_ "github.com/go-sql-driver/mysql"
+	"github.com/oklog/ulid/v2"
 	"github.com/takuchi17/term-keeper/configs"
 )
 
@@ -39,3 +43,57 @@ func NewDB(instance int) (*sql.DB, error) {
 		return nil, errors.New("invalid sql db instance")
 	}
 }
+
+// WithTx runs fn as one unit of work and rolls back everything fn did when it
+// returns an error. Given a *sql.DB a new transaction is started. Given a
+// *sql.Tx (e.g. in tests, or when WithTx is nested) fn runs inside a savepoint
+// of that transaction so that only its own statements are undone.
+func WithTx(db SQLExecutor, fn func(tx SQLExecutor) error) error {
+	switch d := db.(type) {
+	case *sql.DB:
+		tx, err := d.Begin()
+		if err != nil {
+			slog.Error("Failed to begin transaction", "err", err)
+			return err
+		}
+		if err := fn(tx); err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				slog.Error("Failed to rollback transaction", "err", rbErr)
+			}
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			slog.Error("Failed to commit transaction", "err", err)
+			return err
+		}
+		return nil
+
+	case *sql.Tx:
+		return withSavepoint(d, fn)
+
+	default:
+		return errors.New("executor does not support transactions")
+	}
+}
+
+func withSavepoint(tx *sql.Tx, fn func(tx SQLExecutor) error) error {
+	t := time.Now()
+	entropy := ulid.Monotonic(rand.New(rand.NewSource(t.UnixNano())), 0)
+	savepoint := "sp_" + ulid.MustNew(ulid.Timestamp(t), entropy).String()
+
+	if _, err := tx.Exec("SAVEPOINT " + savepoint); err != nil {
+		slog.Error("Failed to create savepoint", "err", err)
+		return err
+	}
+	if err := fn(tx); err != nil {
+		if _, rbErr := tx.Exec("ROLLBACK TO SAVEPOINT " + savepoint); rbErr != nil {
+			slog.Error("Failed to rollback to savepoint", "err", rbErr)
+		}
+		return err
+	}
+	if _, err := tx.Exec("RELEASE SAVEPOINT " + savepoint); err != nil {
+		slog.Error("Failed to release savepoint", "err", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/takuchi17/term-keeper/app/models/queries"
+	"github.com/takuchi17/term-keeper/pkg/ratelimit"
+)
+
+type LoginResult string
+
+const (
+	LoginResultSucceeded  LoginResult = "succeeded"
+	LoginResultFailed     LoginResult = "failed"
+	LoginResultLocked     LoginResult = "locked"
+	LoginResultUnverified LoginResult = "unverified"
+	// パスワードは正しく、二段階認証のコードを待っている
+	LoginResultChallenged LoginResult = "challenged"
+)
+
+type LoginAttempt struct {
+	ID        string
+	Email     Email
+	FKUserId  *UserId
+	IPAddress string
+	Result    LoginResult
+	CreatedAt time.Time
+}
+
+// RecordLoginAttempt stores a login attempt for audit.
+func RecordLoginAttempt(db SQLExecutor, attempt *LoginAttempt) error {
+	attempt.ID = newULID()
+	attempt.CreatedAt = time.Now()
+	_, err := db.Exec(queries.CreateLoginAttempt, attempt.ID, attempt.Email, attempt.FKUserId, attempt.IPAddress, attempt.Result, attempt.CreatedAt)
+	if err != nil {
+		slog.Error("Failed to record login attempt", "err", err)
+		return err
+	}
+	return nil
+}
+
+// GetLoginAttemptsByEmail returns the login attempts for the email, newest first.
+func GetLoginAttemptsByEmail(db SQLExecutor, email Email) ([]*LoginAttempt, error) {
+	rows, err := db.Query(queries.GetLoginAttemptsByEmail, email)
+	if err != nil {
+		slog.Error("Failed to get login attempts", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	attempts := []*LoginAttempt{}
+	for rows.Next() {
+		var attempt LoginAttempt
+		if err := rows.Scan(&attempt.ID, &attempt.Email, &attempt.FKUserId, &attempt.IPAddress, &attempt.Result, &attempt.CreatedAt); err != nil {
+			slog.Error("Failed to scan login attempt", "err", err)
+			return nil, err
+		}
+		attempts = append(attempts, &attempt)
+	}
+	return attempts, rows.Err()
+}
+
+// PurgeLoginAttempts deletes the login attempts recorded before the given time,
+// batchSize rows at a time, and returns how many were deleted.
+func PurgeLoginAttempts(db SQLExecutor, before time.Time, batchSize int) (int, error) {
+	deleted, err := purgeInBatches(db, queries.PurgeLoginAttemptsBefore, before, batchSize)
+	if err != nil {
+		slog.Error("Failed to purge login attempts", "err", err)
+		return deleted, err
+	}
+	return deleted, nil
+}
+
+// LoginThrottleStore is a ratelimit.Store backed by MySQL so that every
+// instance sees the same failures.
+type LoginThrottleStore struct {
+	DB SQLExecutor
+}
+
+var _ ratelimit.Store = (*LoginThrottleStore)(nil)
+
+func (s *LoginThrottleStore) Get(ctx context.Context, key string) (ratelimit.State, error) {
+	state, err := getLoginThrottle(s.DB, queries.GetLoginThrottle, key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ratelimit.State{}, nil
+	}
+	return state, err
+}
+
+func (s *LoginThrottleStore) Update(ctx context.Context, key string, fn func(ratelimit.State) ratelimit.State) (ratelimit.State, error) {
+	var state ratelimit.State
+	err := WithTx(s.DB, func(tx SQLExecutor) error {
+		// 行が無いと FOR UPDATE でロックできないので先に作る
+		if _, err := tx.Exec(queries.CreateLoginThrottleIfNotExists, key); err != nil {
+			slog.Error("Failed to create login throttle", "err", err)
+			return err
+		}
+		current, err := getLoginThrottle(tx, queries.GetLoginThrottleForUpdate, key)
+		if err != nil {
+			return err
+		}
+
+		state = fn(current)
+		_, err = tx.Exec(queries.UpdateLoginThrottle, state.Failures, nullableTime(state.LastFailedAt), nullableTime(state.LockedUntil), key)
+		if err != nil {
+			slog.Error("Failed to update login throttle", "err", err)
+			return err
+		}
+		return nil
+	})
+	return state, err
+}
+
+func (s *LoginThrottleStore) Delete(ctx context.Context, key string) error {
+	if _, err := s.DB.Exec(queries.DeleteLoginThrottle, key); err != nil {
+		slog.Error("Failed to delete login throttle", "err", err)
+		return err
+	}
+	return nil
+}
+
+func getLoginThrottle(db SQLExecutor, query string, key string) (ratelimit.State, error) {
+	var (
+		state        ratelimit.State
+		lastFailedAt *time.Time
+		lockedUntil  *time.Time
+	)
+	err := db.QueryRow(query, key).Scan(&state.Failures, &lastFailedAt, &lockedUntil)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			slog.Error("Failed to get login throttle", "err", err)
+		}
+		return ratelimit.State{}, err
+	}
+	if lastFailedAt != nil {
+		state.LastFailedAt = *lastFailedAt
+	}
+	if lockedUntil != nil {
+		state.LockedUntil = *lockedUntil
+	}
+	return state, nil
+}
+
+// ゼロ値の時刻は NULL として保存する
+func nullableTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takuchi17/term-keeper/pkg/ratelimit"
+)
+
+func TestRecordLoginAttempt(t *testing.T) {
+	userId := UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	err = RecordLoginAttempt(tx, &LoginAttempt{Email: "yamada@example.com", FKUserId: &userId, IPAddress: "192.0.2.1", Result: LoginResultFailed})
+	require.NoError(t, err)
+	err = RecordLoginAttempt(tx, &LoginAttempt{Email: "yamada@example.com", FKUserId: &userId, IPAddress: "192.0.2.1", Result: LoginResultSucceeded})
+	require.NoError(t, err)
+	err = RecordLoginAttempt(tx, &LoginAttempt{Email: "unknown@example.com", IPAddress: "192.0.2.1", Result: LoginResultFailed})
+	require.NoError(t, err)
+
+	attempts, err := GetLoginAttemptsByEmail(tx, "yamada@example.com")
+	require.NoError(t, err)
+	require.Len(t, attempts, 2)
+	assert.Equal(t, LoginResultSucceeded, attempts[0].Result, "Newest attempt should come first")
+	assert.Equal(t, LoginResultFailed, attempts[1].Result)
+	assert.Equal(t, &userId, attempts[1].FKUserId)
+
+	attempts, err = GetLoginAttemptsByEmail(tx, "unknown@example.com")
+	require.NoError(t, err)
+	require.Len(t, attempts, 1)
+	assert.Nil(t, attempts[0].FKUserId, "Unknown email should not have a user")
+}
+
+func TestLoginThrottleStore(t *testing.T) {
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	ctx := context.Background()
+	now := time.Date(2024, 4, 1, 12, 0, 0, 0, time.Local)
+	limiter := ratelimit.NewLimiter(&LoginThrottleStore{DB: tx}, "account:", ratelimit.Policy{
+		FreeAttempts: 1,
+		BaseDelay:    time.Minute,
+		MaxDelay:     time.Hour,
+		ResetAfter:   time.Hour,
+	})
+	limiter.Now = func() time.Time { return now }
+
+	retryAfter, err := limiter.Allow(ctx, "yamada@example.com")
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter, "Unknown key should not be locked")
+
+	retryAfter, err = limiter.Fail(ctx, "yamada@example.com")
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter)
+	retryAfter, err = limiter.Fail(ctx, "yamada@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, retryAfter)
+
+	retryAfter, err = limiter.Allow(ctx, "yamada@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, retryAfter, "Lock should be stored")
+
+	require.NoError(t, limiter.Reset(ctx, "yamada@example.com"))
+	retryAfter, err = limiter.Allow(ctx, "yamada@example.com")
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter, "Lock should be removed")
+}
+
+func TestPurgeLoginAttempts(t *testing.T) {
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	now := time.Now()
+	for _, email := range []Email{"old1@example.com", "old2@example.com", "new@example.com"} {
+		err = RecordLoginAttempt(tx, &LoginAttempt{Email: email, IPAddress: "192.0.2.1", Result: LoginResultFailed})
+		require.NoError(t, err)
+	}
+	_, err = tx.Exec(`UPDATE login_attempts SET created_at = ? WHERE email IN (?, ?)`, now.Add(-100*24*time.Hour), "old1@example.com", "old2@example.com")
+	require.NoError(t, err)
+
+	deleted, err := PurgeLoginAttempts(tx, now.Add(-90*24*time.Hour), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+
+	attempts, err := GetLoginAttemptsByEmail(tx, "new@example.com")
+	require.NoError(t, err)
+	assert.Len(t, attempts, 1, "Recent attempt should be kept")
+}
@@ -0,0 +1,256 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/takuchi17/term-keeper/app/models/queries"
+)
+
+type (
+	PersonalAccessTokenId    string
+	PersonalAccessTokenName  string
+	PersonalAccessTokenScope string
+)
+
+const (
+	ScopeTermsRead       PersonalAccessTokenScope = "terms:read"
+	ScopeTermsWrite      PersonalAccessTokenScope = "terms:write"
+	ScopeCategoriesRead  PersonalAccessTokenScope = "categories:read"
+	ScopeCategoriesWrite PersonalAccessTokenScope = "categories:write"
+)
+
+// PersonalAccessTokenScopes are every scope a token can be given.
+var PersonalAccessTokenScopes = []PersonalAccessTokenScope{
+	ScopeTermsRead,
+	ScopeTermsWrite,
+	ScopeCategoriesRead,
+	ScopeCategoriesWrite,
+}
+
+// PersonalAccessTokenPrefix starts every personal access token so that it can
+// be told apart from a JWT and found by secret scanners. The auth middleware
+// has the same constant.
+const PersonalAccessTokenPrefix = "tkp_"
+
+type PersonalAccessToken struct {
+	ID       PersonalAccessTokenId
+	FKUserId UserId
+	// 認証のときだけ users から読み込む
+	UserName    UserName
+	Name        PersonalAccessTokenName
+	TokenPrefix string
+	Scopes      []PersonalAccessTokenScope
+	ExpiresAt   *time.Time
+	LastUsedAt  *time.Time
+	CreatedAt   time.Time
+}
+
+var (
+	ErrPersonalAccessTokenNameRequired = errors.New("personal access token name is required")
+	ErrPersonalAccessTokenNameTooLong  = errors.New("personal access token name is too long")
+	ErrInvalidScope                    = errors.New("invalid personal access token scope")
+	ErrScopeRequired                   = errors.New("at least one scope is required")
+	ErrPersonalAccessTokenExpired      = errors.New("expiry of personal access token must be in the future")
+	ErrTooManyPersonalAccessTokens     = errors.New("too many personal access tokens")
+	ErrPersonalAccessTokenNotFound     = errors.New("personal access token not found")
+)
+
+const (
+	maxPersonalAccessTokenNameLength = 100
+	maxPersonalAccessTokensPerUser   = 50
+	// 一覧に表示するトークンの長さ (プレフィックスを含む)
+	personalAccessTokenDisplayLength = 12
+	// last_used_at を更新する間隔
+	personalAccessTokenLastUsedInterval = time.Minute
+)
+
+// HasScope reports whether the token was given the scope.
+func (t *PersonalAccessToken) HasScope(scope PersonalAccessTokenScope) bool {
+	return slices.Contains(t.Scopes, scope)
+}
+
+// CreatePersonalAccessToken creates a token for the user and returns it with
+// the plain token, which is shown only once. A nil expiresAt never expires.
+func CreatePersonalAccessToken(db SQLExecutor, userId UserId, name PersonalAccessTokenName, scopes []PersonalAccessTokenScope, expiresAt *time.Time) (*PersonalAccessToken, string, error) {
+	name = PersonalAccessTokenName(strings.TrimSpace(string(name)))
+	if name == "" {
+		return nil, "", ErrPersonalAccessTokenNameRequired
+	}
+	if utf8.RuneCountInString(string(name)) > maxPersonalAccessTokenNameLength {
+		return nil, "", ErrPersonalAccessTokenNameTooLong
+	}
+	scopes, err := normalizeScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+	now := time.Now()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, "", ErrPersonalAccessTokenExpired
+	}
+
+	secret, err := generateOpaqueToken()
+	if err != nil {
+		return nil, "", err
+	}
+	plainToken := PersonalAccessTokenPrefix + secret
+
+	token := &PersonalAccessToken{
+		ID:          PersonalAccessTokenId(newULID()),
+		FKUserId:    userId,
+		Name:        name,
+		TokenPrefix: plainToken[:personalAccessTokenDisplayLength],
+		Scopes:      scopes,
+		ExpiresAt:   expiresAt,
+		CreatedAt:   now,
+	}
+
+	err = WithTx(db, func(tx SQLExecutor) error {
+		var count int
+		if err := tx.QueryRow(queries.CountActivePersonalAccessTokensByUserId, userId).Scan(&count); err != nil {
+			slog.Error("Failed to count personal access tokens", "err", err)
+			return err
+		}
+		if count >= maxPersonalAccessTokensPerUser {
+			return ErrTooManyPersonalAccessTokens
+		}
+
+		_, err := tx.Exec(queries.CreatePersonalAccessToken,
+			token.ID, userId, name, hashToken(plainToken), token.TokenPrefix, joinScopes(scopes), expiresAt, now,
+		)
+		if err != nil {
+			slog.Error("Failed to create personal access token", "err", err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return token, plainToken, nil
+}
+
+// GetPersonalAccessTokensByUserId returns the tokens of the user that were not
+// revoked, newest first. Expired tokens are included.
+func GetPersonalAccessTokensByUserId(db SQLExecutor, userId UserId) ([]*PersonalAccessToken, error) {
+	rows, err := db.Query(queries.GetPersonalAccessTokensByUserId, userId)
+	if err != nil {
+		slog.Error("Failed to get personal access tokens", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := []*PersonalAccessToken{}
+	for rows.Next() {
+		var (
+			token  PersonalAccessToken
+			scopes string
+		)
+		if err := rows.Scan(&token.ID, &token.FKUserId, &token.Name, &token.TokenPrefix, &scopes, &token.ExpiresAt, &token.LastUsedAt, &token.CreatedAt); err != nil {
+			slog.Error("Failed to scan personal access token", "err", err)
+			return nil, err
+		}
+		token.Scopes = splitScopes(scopes)
+		tokens = append(tokens, &token)
+	}
+	return tokens, rows.Err()
+}
+
+// RevokePersonalAccessToken revokes a token of the user. Tokens of other users
+// are reported as not found.
+func RevokePersonalAccessToken(db SQLExecutor, userId UserId, id PersonalAccessTokenId) error {
+	result, err := db.Exec(queries.RevokePersonalAccessToken, time.Now(), id, userId)
+	if err != nil {
+		slog.Error("Failed to revoke personal access token", "err", err)
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrPersonalAccessTokenNotFound
+	}
+	return nil
+}
+
+// AuthenticatePersonalAccessToken returns the token with the name of its owner
+// and records that it was used. Unknown, expired and revoked tokens return nil.
+func AuthenticatePersonalAccessToken(db SQLExecutor, plainToken string) (*PersonalAccessToken, error) {
+	if !strings.HasPrefix(plainToken, PersonalAccessTokenPrefix) {
+		return nil, nil
+	}
+
+	var (
+		token  PersonalAccessToken
+		scopes string
+	)
+	err := db.QueryRow(queries.GetPersonalAccessTokenByHash, hashToken(plainToken)).Scan(
+		&token.ID, &token.FKUserId, &token.UserName, &token.Name, &token.TokenPrefix, &scopes, &token.ExpiresAt, &token.LastUsedAt, &token.CreatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		slog.Error("Failed to get personal access token", "err", err)
+		return nil, err
+	}
+	token.Scopes = splitScopes(scopes)
+
+	now := time.Now()
+	if token.ExpiresAt != nil && !now.Before(*token.ExpiresAt) {
+		return nil, nil
+	}
+
+	if _, err := db.Exec(queries.UpdatePersonalAccessTokenLastUsedAt, now, token.ID, now.Add(-personalAccessTokenLastUsedInterval)); err != nil {
+		slog.Error("Failed to update last used time of personal access token", "err", err)
+		return nil, err
+	}
+	token.LastUsedAt = &now
+
+	return &token, nil
+}
+
+// 重複を除き、PersonalAccessTokenScopes の順に並べる
+func normalizeScopes(scopes []PersonalAccessTokenScope) ([]PersonalAccessTokenScope, error) {
+	if len(scopes) == 0 {
+		return nil, ErrScopeRequired
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(PersonalAccessTokenScopes, scope) {
+			return nil, ErrInvalidScope
+		}
+	}
+
+	normalized := []PersonalAccessTokenScope{}
+	for _, scope := range PersonalAccessTokenScopes {
+		if slices.Contains(scopes, scope) {
+			normalized = append(normalized, scope)
+		}
+	}
+	return normalized, nil
+}
+
+func joinScopes(scopes []PersonalAccessTokenScope) string {
+	s := make([]string, len(scopes))
+	for i, scope := range scopes {
+		s[i] = string(scope)
+	}
+	return strings.Join(s, ",")
+}
+
+func splitScopes(s string) []PersonalAccessTokenScope {
+	scopes := []PersonalAccessTokenScope{}
+	for _, scope := range strings.Split(s, ",") {
+		if scope != "" {
+			scopes = append(scopes, PersonalAccessTokenScope(scope))
+		}
+	}
+	return scopes
+}
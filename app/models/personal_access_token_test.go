@@ -0,0 +1,160 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takuchi17/term-keeper/pkg/util"
+)
+
+func TestCreatePersonalAccessToken(t *testing.T) {
+	const userId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+
+	testCases := []struct {
+		name           string
+		tokenName      PersonalAccessTokenName
+		scopes         []PersonalAccessTokenScope
+		expiresAt      *time.Time
+		expectedScopes []PersonalAccessTokenScope
+		wantErr        error
+	}{
+		{
+			name:           "Scopes are deduplicated and sorted",
+			tokenName:      "nightly export",
+			scopes:         []PersonalAccessTokenScope{ScopeCategoriesRead, ScopeTermsRead, ScopeTermsRead},
+			expiresAt:      util.Ptr(time.Now().Add(24 * time.Hour)),
+			expectedScopes: []PersonalAccessTokenScope{ScopeTermsRead, ScopeCategoriesRead},
+		},
+		{
+			name:           "Token without expiry",
+			tokenName:      "import",
+			scopes:         []PersonalAccessTokenScope{ScopeTermsWrite},
+			expectedScopes: []PersonalAccessTokenScope{ScopeTermsWrite},
+		},
+		{
+			name:      "Empty name",
+			tokenName: "  ",
+			scopes:    []PersonalAccessTokenScope{ScopeTermsRead},
+			wantErr:   ErrPersonalAccessTokenNameRequired,
+		},
+		{
+			name:      "Name too long",
+			tokenName: PersonalAccessTokenName(strings.Repeat("あ", 101)),
+			scopes:    []PersonalAccessTokenScope{ScopeTermsRead},
+			wantErr:   ErrPersonalAccessTokenNameTooLong,
+		},
+		{
+			name:      "Unknown scope",
+			tokenName: "admin",
+			scopes:    []PersonalAccessTokenScope{"users:write"},
+			wantErr:   ErrInvalidScope,
+		},
+		{
+			name:      "No scope",
+			tokenName: "nothing",
+			wantErr:   ErrScopeRequired,
+		},
+		{
+			name:      "Expiry in the past",
+			tokenName: "expired",
+			scopes:    []PersonalAccessTokenScope{ScopeTermsRead},
+			expiresAt: util.Ptr(time.Now().Add(-time.Minute)),
+			wantErr:   ErrPersonalAccessTokenExpired,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+
+			token, plainToken, err := CreatePersonalAccessToken(tx, userId, tc.tokenName, tc.scopes, tc.expiresAt)
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+
+			assert.NoError(t, err, "Expected no error, but an error occurred.")
+			assert.True(t, strings.HasPrefix(plainToken, PersonalAccessTokenPrefix), "Token should have the prefix")
+			assert.True(t, strings.HasPrefix(plainToken, token.TokenPrefix), "Token prefix mismatch")
+			assert.Equal(t, tc.expectedScopes, token.Scopes, "Scopes mismatch")
+
+			tokens, err := GetPersonalAccessTokensByUserId(tx, userId)
+			require.NoError(t, err)
+			require.Len(t, tokens, 1)
+			assert.Equal(t, token.ID, tokens[0].ID)
+			assert.Equal(t, tc.expectedScopes, tokens[0].Scopes, "Stored scopes mismatch")
+			assert.Nil(t, tokens[0].LastUsedAt, "Token should not be used yet")
+		})
+	}
+}
+
+func TestAuthenticatePersonalAccessToken(t *testing.T) {
+	const (
+		userId      = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+		otherUserId = UserId("01HGDJ5HXZD3K6WFYS9JU0A1XG")
+	)
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	token, plainToken, err := CreatePersonalAccessToken(tx, userId, "script", []PersonalAccessTokenScope{ScopeTermsRead}, nil)
+	require.NoError(t, err)
+
+	authenticated, err := AuthenticatePersonalAccessToken(tx, plainToken)
+	require.NoError(t, err)
+	require.NotNil(t, authenticated)
+	assert.Equal(t, userId, authenticated.FKUserId)
+	assert.Equal(t, UserName("山田太郎"), authenticated.UserName, "Owner name should be loaded")
+	assert.True(t, authenticated.HasScope(ScopeTermsRead))
+	assert.False(t, authenticated.HasScope(ScopeTermsWrite))
+
+	tokens, err := GetPersonalAccessTokensByUserId(tx, userId)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.NotNil(t, tokens[0].LastUsedAt, "Last used time should be recorded")
+
+	unknown, err := AuthenticatePersonalAccessToken(tx, PersonalAccessTokenPrefix+"unknown")
+	require.NoError(t, err)
+	assert.Nil(t, unknown)
+
+	// 他のユーザーのトークンは無効にできない
+	err = RevokePersonalAccessToken(tx, otherUserId, token.ID)
+	assert.ErrorIs(t, err, ErrPersonalAccessTokenNotFound)
+
+	err = RevokePersonalAccessToken(tx, userId, token.ID)
+	require.NoError(t, err)
+	err = RevokePersonalAccessToken(tx, userId, token.ID)
+	assert.ErrorIs(t, err, ErrPersonalAccessTokenNotFound, "Token should already be revoked")
+
+	revoked, err := AuthenticatePersonalAccessToken(tx, plainToken)
+	require.NoError(t, err)
+	assert.Nil(t, revoked, "Revoked token should not be accepted")
+
+	tokens, err = GetPersonalAccessTokensByUserId(tx, userId)
+	require.NoError(t, err)
+	assert.Empty(t, tokens, "Revoked token should not be listed")
+}
+
+func TestAuthenticateExpiredPersonalAccessToken(t *testing.T) {
+	const userId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	_, plainToken, err := CreatePersonalAccessToken(tx, userId, "short", []PersonalAccessTokenScope{ScopeTermsRead}, util.Ptr(time.Now().Add(time.Hour)))
+	require.NoError(t, err)
+	_, err = tx.Exec("UPDATE personal_access_tokens SET expires_at = ? WHERE fk_user_id = ?", time.Now().Add(-time.Minute), userId)
+	require.NoError(t, err)
+
+	token, err := AuthenticatePersonalAccessToken(tx, plainToken)
+	require.NoError(t, err)
+	assert.Nil(t, token, "Expired token should not be accepted")
+}
@@ -0,0 +1,109 @@
+package queries
+
+const CreateRefreshToken = `
+INSERT INTO refresh_tokens
+(
+	id,
+	fk_user_id,
+	family_id,
+	token_hash,
+	expires_at,
+	created_at
+)
+VALUES
+(
+	?,
+	?,
+	?,
+	?,
+	?,
+	?
+)
+`
+
+const GetRefreshTokenByHashForUpdate = `
+SELECT
+	id, fk_user_id, family_id, expires_at, used_at, revoked_at
+FROM
+	refresh_tokens
+WHERE
+	token_hash = ?
+FOR UPDATE
+`
+
+const MarkRefreshTokenUsed = `
+UPDATE
+	refresh_tokens
+SET
+	used_at = ?
+WHERE
+	id = ?
+`
+
+const RevokeRefreshTokenFamily = `
+UPDATE
+	refresh_tokens
+SET
+	revoked_at = ?
+WHERE
+	family_id = ? AND revoked_at IS NULL
+`
+
+const RevokeRefreshTokensByUserId = `
+UPDATE
+	refresh_tokens
+SET
+	revoked_at = ?
+WHERE
+	fk_user_id = ? AND revoked_at IS NULL
+`
+
+const CreateRevokedAccessToken = `
+INSERT IGNORE INTO revoked_access_tokens
+(
+	jti,
+	expires_at
+)
+VALUES
+(
+	?,
+	?
+)
+`
+
+const UpdateUserTokensValidAfter = `
+UPDATE
+	users
+SET
+	tokens_valid_after = ?
+WHERE
+	id = ?
+`
+
+// 拒否リストにあるか、ユーザーがそれより後に発行したトークンだけを有効にしているか、ユーザーが削除された
+const IsAccessTokenRevoked = `
+SELECT
+	EXISTS (
+		SELECT 1 FROM revoked_access_tokens WHERE jti = ?
+	) OR NOT EXISTS (
+		SELECT 1 FROM users WHERE id = ? AND (tokens_valid_after IS NULL OR tokens_valid_after < ?)
+	)
+`
+
+const PurgeRefreshTokensExpiredBefore = `
+DELETE
+FROM
+	refresh_tokens
+WHERE
+	expires_at < ?
+LIMIT ?
+`
+
+const PurgeRevokedAccessTokensExpiredBefore = `
+DELETE
+FROM
+	revoked_access_tokens
+WHERE
+	expires_at < ?
+LIMIT ?
+`
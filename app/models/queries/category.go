@@ -0,0 +1,90 @@
+package queries
+
+const CreateCategory = `
+INSERT INTO categories
+(
+	id,
+	fk_user_id,
+	name,
+	hex_color_code,
+	created_at,
+	updated_at
+)
+VALUES
+(
+	?,
+	?,
+	?,
+	?,
+	?,
+	?
+)
+`
+
+const GetCategoryById = `
+SELECT
+	id, name, fk_user_id, COALESCE(hex_color_code, ''), created_at, updated_at
+FROM
+	categories
+WHERE
+	id = ? AND deleted_at IS NULL
+`
+
+// GetCategoriesByIdsBase is followed by a placeholder list "(?, ?, ...)"
+const GetCategoriesByIdsBase = `
+SELECT
+	id, name, fk_user_id, COALESCE(hex_color_code, ''), created_at, updated_at
+FROM
+	categories
+WHERE
+	deleted_at IS NULL AND id IN
+`
+
+const GetCategoriesByUserId = `
+SELECT
+	id, name, fk_user_id, COALESCE(hex_color_code, ''), created_at, updated_at
+FROM
+	categories
+WHERE
+	fk_user_id = ? AND deleted_at IS NULL
+ORDER BY
+	created_at ASC, id ASC
+`
+
+const IsDuplicateCategoryName = `
+SELECT
+	COUNT(*)
+FROM
+	categories
+WHERE
+	fk_user_id = ? AND name = ? AND id != ? AND deleted_at IS NULL
+`
+
+// CountCategoriesByUserIdAndIdsBase is followed by a placeholder list "(?, ?, ...)"
+const CountCategoriesByUserIdAndIdsBase = `
+SELECT
+	COUNT(*)
+FROM
+	categories
+WHERE
+	fk_user_id = ? AND deleted_at IS NULL AND id IN
+`
+
+const UpdateCategory = `
+UPDATE
+	categories
+SET
+	name = ?, hex_color_code = ?, updated_at = ?
+WHERE
+	id = ? AND deleted_at IS NULL
+`
+
+// カテゴリはゴミ箱に移すだけで、用語との紐付けは残す
+const DeleteCategory = `
+UPDATE
+	categories
+SET
+	deleted_at = ?
+WHERE
+	id = ? AND deleted_at IS NULL
+`
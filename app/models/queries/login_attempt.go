@@ -0,0 +1,84 @@
+package queries
+
+const CreateLoginAttempt = `
+INSERT INTO login_attempts
+(
+	id,
+	email,
+	fk_user_id,
+	ip_address,
+	result,
+	created_at
+)
+VALUES
+(
+	?,
+	?,
+	?,
+	?,
+	?,
+	?
+)
+`
+
+const GetLoginAttemptsByEmail = `
+SELECT
+	id, email, fk_user_id, ip_address, result, created_at
+FROM
+	login_attempts
+WHERE
+	email = ?
+ORDER BY
+	created_at DESC, id DESC
+`
+
+const CreateLoginThrottleIfNotExists = `
+INSERT IGNORE INTO login_throttles
+(
+	throttle_key
+)
+VALUES
+(
+	?
+)
+`
+
+const GetLoginThrottle = `
+SELECT
+	failures, last_failed_at, locked_until
+FROM
+	login_throttles
+WHERE
+	throttle_key = ?
+`
+
+const GetLoginThrottleForUpdate = GetLoginThrottle + `FOR UPDATE
+`
+
+const UpdateLoginThrottle = `
+UPDATE
+	login_throttles
+SET
+	failures = ?,
+	last_failed_at = ?,
+	locked_until = ?
+WHERE
+	throttle_key = ?
+`
+
+const DeleteLoginThrottle = `
+DELETE
+FROM
+	login_throttles
+WHERE
+	throttle_key = ?
+`
+
+const PurgeLoginAttemptsBefore = `
+DELETE
+FROM
+	login_attempts
+WHERE
+	created_at < ?
+LIMIT ?
+`
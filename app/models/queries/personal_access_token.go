@@ -0,0 +1,75 @@
+package queries
+
+const CreatePersonalAccessToken = `
+INSERT INTO personal_access_tokens
+(
+	id,
+	fk_user_id,
+	name,
+	token_hash,
+	token_prefix,
+	scopes,
+	expires_at,
+	created_at
+)
+VALUES
+(
+	?,
+	?,
+	?,
+	?,
+	?,
+	?,
+	?,
+	?
+)
+`
+
+const CountActivePersonalAccessTokensByUserId = `
+SELECT
+	COUNT(*)
+FROM
+	personal_access_tokens
+WHERE
+	fk_user_id = ? AND revoked_at IS NULL
+`
+
+const GetPersonalAccessTokensByUserId = `
+SELECT
+	id, fk_user_id, name, token_prefix, scopes, expires_at, last_used_at, created_at
+FROM
+	personal_access_tokens
+WHERE
+	fk_user_id = ? AND revoked_at IS NULL
+ORDER BY
+	created_at DESC, id DESC
+`
+
+const GetPersonalAccessTokenByHash = `
+SELECT
+	t.id, t.fk_user_id, u.name, t.name, t.token_prefix, t.scopes, t.expires_at, t.last_used_at, t.created_at
+FROM
+	personal_access_tokens AS t
+	INNER JOIN users AS u ON u.id = t.fk_user_id
+WHERE
+	t.token_hash = ? AND t.revoked_at IS NULL
+`
+
+// 使うたびに書き込まないように、前回から一定時間たったときだけ更新する
+const UpdatePersonalAccessTokenLastUsedAt = `
+UPDATE
+	personal_access_tokens
+SET
+	last_used_at = ?
+WHERE
+	id = ? AND (last_used_at IS NULL OR last_used_at < ?)
+`
+
+const RevokePersonalAccessToken = `
+UPDATE
+	personal_access_tokens
+SET
+	revoked_at = ?
+WHERE
+	id = ? AND fk_user_id = ? AND revoked_at IS NULL
+`
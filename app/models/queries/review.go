@@ -0,0 +1,119 @@
+package queries
+
+// 予定を過ぎた用語を古い順に、そのあとに一度も復習していない用語を登録順に並べる
+const GetDueReviewsByUserId = `
+SELECT
+	t.id, t.name, COALESCE(t.description, ''),
+	r.ease, r.interval_days, r.repetitions, r.lapses, r.due_at, r.last_reviewed_at
+FROM
+	terms t
+LEFT JOIN
+	term_reviews r ON r.fk_term_id = t.id
+WHERE
+	t.fk_user_id = ? AND t.deleted_at IS NULL
+AND
+	(r.due_at IS NULL OR r.due_at <= ?)
+ORDER BY
+	r.due_at IS NULL ASC, r.due_at ASC, t.created_at ASC, t.id ASC
+LIMIT ?
+`
+
+const CountDueReviewsByUserId = `
+SELECT
+	COUNT(*)
+FROM
+	terms t
+LEFT JOIN
+	term_reviews r ON r.fk_term_id = t.id
+WHERE
+	t.fk_user_id = ? AND t.deleted_at IS NULL
+AND
+	(r.due_at IS NULL OR r.due_at <= ?)
+`
+
+const GetTermReviewForUpdate = `
+SELECT
+	ease, interval_days, repetitions, lapses, due_at, last_reviewed_at
+FROM
+	term_reviews
+WHERE
+	fk_term_id = ?
+FOR UPDATE
+`
+
+const UpsertTermReview = `
+INSERT INTO term_reviews
+(
+	fk_term_id,
+	fk_user_id,
+	ease,
+	interval_days,
+	repetitions,
+	lapses,
+	due_at,
+	last_reviewed_at
+)
+VALUES
+(
+	?,
+	?,
+	?,
+	?,
+	?,
+	?,
+	?,
+	?
+)
+ON DUPLICATE KEY UPDATE
+	ease = VALUES(ease),
+	interval_days = VALUES(interval_days),
+	repetitions = VALUES(repetitions),
+	lapses = VALUES(lapses),
+	due_at = VALUES(due_at),
+	last_reviewed_at = VALUES(last_reviewed_at)
+`
+
+const CreateReviewLog = `
+INSERT INTO review_logs
+(
+	id,
+	fk_user_id,
+	fk_term_id,
+	grade,
+	ease,
+	interval_days,
+	due_at,
+	reviewed_at
+)
+VALUES
+(
+	?,
+	?,
+	?,
+	?,
+	?,
+	?,
+	?,
+	?
+)
+`
+
+const GetReviewLogsByUserIdBase = `
+SELECT
+	id, fk_term_id, grade, ease, interval_days, due_at, reviewed_at
+FROM
+	review_logs
+WHERE
+	fk_user_id = ? AND fk_term_id NOT IN (SELECT id FROM terms WHERE deleted_at IS NOT NULL)
+`
+
+const GetReviewLogsFilterByTermId = `
+AND
+	fk_term_id = ?
+`
+
+const GetReviewLogsOrder = `
+ORDER BY
+	reviewed_at DESC, id DESC
+LIMIT ?
+`
@@ -7,6 +7,8 @@ INSERT INTO terms
 	fk_user_id,
 	name,
 	description,
+	status,
+	status_changed_at,
 	created_at,
 	updated_at
 )
@@ -17,27 +19,31 @@ VALUES
 	?,
 	?,
 	?,
+	?,
+	?,
 	?
 )
 `
 
 const GetTermsByUserIdBase = `
 SELECT 
-	t.id, t.fk_user_id, t.name, t.description, t.created_at, t.updated_at
+	t.id, t.fk_user_id, t.name, t.description, t.status, t.status_changed_at, t.created_at, t.updated_at
 FROM 
 	terms t
 `
 
-const GetTermsJoinWithCategory = `
-INNER JOIN 
-	term_category_relations r 
-ON
-	t.id = r.fk_term_id
+const GetTermById = `
+SELECT
+	id, fk_user_id, name, description, status, status_changed_at, created_at, updated_at
+FROM
+	terms
+WHERE
+	id = ? AND deleted_at IS NULL
 `
 
 const GetTermsByUserIdWhere = `
 WHERE
-	t.fk_user_id = ?
+	t.fk_user_id = ? AND t.deleted_at IS NULL
 `
 
 const GetTermsFillterByName = `
@@ -45,48 +51,171 @@ AND
 	t.name LIKE ?
 `
 
-const GetTermsFillterByCategory = `
+const GetTermsFilterByFulltext = `
 AND
-	r.fk_category_id = ?
+	MATCH(t.name, t.description) AGAINST (? IN NATURAL LANGUAGE MODE)
+`
+
+// カテゴリの条件は AND または OR でつないで WHERE に追加する
+
+// GetTermsHasAnyCategoryBase is followed by a placeholder list "(?, ?, ...))"
+const GetTermsHasAnyCategoryBase = `
+EXISTS (
+	SELECT
+		1
+	FROM
+		term_category_relations r
+	JOIN
+		categories c ON r.fk_category_id = c.id
+	WHERE
+		c.deleted_at IS NULL AND r.fk_term_id = t.id AND r.fk_category_id IN
+`
+
+// GetTermsCountCategoriesBase is followed by a placeholder list "(?, ?, ...))"
+// and compared with the number of categories
+const GetTermsCountCategoriesBase = `
+(
+	SELECT
+		COUNT(DISTINCT r.fk_category_id)
+	FROM
+		term_category_relations r
+	JOIN
+		categories c ON r.fk_category_id = c.id
+	WHERE
+		c.deleted_at IS NULL AND r.fk_term_id = t.id AND r.fk_category_id IN
 `
 
+// ゴミ箱のカテゴリだけを持つ用語も未分類とみなす
+const GetTermsHasNoCategory = `
+NOT EXISTS (
+	SELECT
+		1
+	FROM
+		term_category_relations r
+	JOIN
+		categories c ON r.fk_category_id = c.id
+	WHERE
+		c.deleted_at IS NULL AND r.fk_term_id = t.id
+)
+`
+
+// サブドメインも含めて一致させる
+const GetTermsFilterBySourceDomain = `
+AND EXISTS (
+	SELECT
+		1
+	FROM
+		term_sources s
+	WHERE
+		s.fk_term_id = t.id AND (s.domain = ? OR s.domain LIKE ?)
+)
+`
+
+// 確認済みは understood と mastered の用語
 const GetTermsFilterByChecked = `
 AND 
   (
-    (? = true AND t.description IS NOT NULL AND t.description != '') OR
-    (? = false AND (t.description IS NULL OR t.description = '')) OR
+    (? = true AND t.status IN ('understood', 'mastered')) OR
+    (? = false AND t.status NOT IN ('understood', 'mastered')) OR
     (? IS NULL)
   )
 `
 
+// GetTermsFilterByStatusBase is followed by a placeholder list "(?, ?, ...)"
+const GetTermsFilterByStatusBase = `
+AND
+	t.status IN
+`
+
+const GetTermsCountBase = `
+SELECT
+	COUNT(*)
+FROM
+	terms t
+`
+
+// 並び順が同じ行は id で順序を決め、カーソルで続きを取得できるようにする
+const GetTermsSortByIdAsc = `
+ORDER BY
+	t.id ASC
+`
+
 const GetTermsSortByCreatedAsc = `
 ORDER BY 
-	t.created_at ASC
+	t.created_at ASC, t.id ASC
 `
 
 const GetTermsSortByCreatedDesc = `
 ORDER BY 
-	t.created_at DESC
+	t.created_at DESC, t.id DESC
 `
 
 const GetTermsSortByUpdatedAsc = `
 ORDER BY 
-	t.updated_at ASC
+	t.updated_at ASC, t.id ASC
 `
 
 const GetTermsSortByUpdatedDesc = `
 ORDER BY 
-	t.updated_at Desc
+	t.updated_at Desc, t.id DESC
 `
 
 const GetTermsSortByNameAsc = `
 ORDER BY 
-	t.name ASC
+	t.name ASC, t.id ASC
 `
 
 const GetTermsSortByNameDesc = `
 ORDER BY 
-	t.name Desc
+	t.name Desc, t.id DESC
+`
+
+const GetTermsSortByRelevance = `
+ORDER BY
+	MATCH(t.name, t.description) AGAINST (? IN NATURAL LANGUAGE MODE) DESC, t.id ASC
+`
+
+const GetTermsAfterId = `
+AND
+	t.id > ?
+`
+
+const GetTermsAfterCreatedAsc = `
+AND
+	(t.created_at > ? OR (t.created_at = ? AND t.id > ?))
+`
+
+const GetTermsAfterCreatedDesc = `
+AND
+	(t.created_at < ? OR (t.created_at = ? AND t.id < ?))
+`
+
+const GetTermsAfterUpdatedAsc = `
+AND
+	(t.updated_at > ? OR (t.updated_at = ? AND t.id > ?))
+`
+
+const GetTermsAfterUpdatedDesc = `
+AND
+	(t.updated_at < ? OR (t.updated_at = ? AND t.id < ?))
+`
+
+const GetTermsAfterNameAsc = `
+AND
+	(t.name > ? OR (t.name = ? AND t.id > ?))
+`
+
+const GetTermsAfterNameDesc = `
+AND
+	(t.name < ? OR (t.name = ? AND t.id < ?))
+`
+
+const GetTermsLimit = `
+LIMIT ?
+`
+
+const GetTermsLimitOffset = `
+LIMIT ? OFFSET ?
 `
 
 const UpdateTerm = `
@@ -95,13 +224,64 @@ UPDATE
 SET
 	name = ?, description = ?, updated_at = ?
 WHERE
-	id = ?
+	id = ? AND deleted_at IS NULL
 `
 
+// 用語はゴミ箱に移すだけで、カテゴリやソースとの紐付けは残す
 const DeleteTerm = `
-DELETE
+UPDATE
+	terms
+SET
+	deleted_at = ?
+WHERE
+	id = ? AND deleted_at IS NULL
+`
+
+const UpdateTermStatus = `
+UPDATE
+	terms
+SET
+	status = ?, status_changed_at = ?
+WHERE
+	id = ? AND deleted_at IS NULL
+`
+
+const CreateTermStatusTransition = `
+INSERT INTO term_status_transitions
+(
+	id,
+	fk_term_id,
+	from_status,
+	to_status,
+	changed_at
+)
+VALUES
+(
+	?,
+	?,
+	?,
+	?,
+	?
+)
+`
+
+const GetTermStatusTransitionsByTermId = `
+SELECT
+	id, fk_term_id, from_status, to_status, changed_at
+FROM
+	term_status_transitions
+WHERE
+	fk_term_id = ?
+ORDER BY
+	changed_at DESC, id DESC
+`
+
+const GetTermStatusForUpdate = `
+SELECT
+	status
 FROM
 	terms
 WHERE
-	id = ?
+	id = ? AND deleted_at IS NULL
+FOR UPDATE
 `
@@ -22,19 +22,39 @@ VALUES
 )
 `
 
+// ゴミ箱のカテゴリとの紐付けは、カテゴリを戻したときのために残す
 const DeleteTermCategoryRelations = `
 DELETE 
 FROM 
 	term_category_relations 
 WHERE
-	fk_term_id = ?;
+	fk_term_id = ? AND fk_category_id NOT IN (SELECT id FROM categories WHERE deleted_at IS NOT NULL);
 `
 
 const GetCategoryIdsByTermId = `
 SELECT 
-	fk_category_id 
+	r.fk_category_id 
 FROM 
-	term_category_relations 
+	term_category_relations r
+JOIN
+	categories c ON r.fk_category_id = c.id
 WHERE 
-	fk_term_id = ?
+	r.fk_term_id = ? AND c.deleted_at IS NULL
+`
+
+// GetCategoriesByTermIdsBase is followed by a placeholder list "(?, ?, ...)"
+const GetCategoriesByTermIdsBase = `
+SELECT
+	r.fk_term_id, c.id, c.name, c.fk_user_id, COALESCE(c.hex_color_code, ''), c.created_at, c.updated_at
+FROM
+	term_category_relations r
+JOIN
+	categories c ON r.fk_category_id = c.id
+WHERE
+	c.deleted_at IS NULL AND r.fk_term_id IN
+`
+
+const GetCategoriesByTermIdsOrder = `
+ORDER BY
+	c.created_at ASC, c.id ASC
 `
@@ -0,0 +1,95 @@
+package queries
+
+const GetTermContentForUpdate = `
+SELECT
+	name, COALESCE(description, '')
+FROM
+	terms
+WHERE
+	id = ? AND deleted_at IS NULL
+FOR UPDATE
+`
+
+const GetLatestTermRevisionNumber = `
+SELECT
+	COALESCE(MAX(revision), 0)
+FROM
+	term_revisions
+WHERE
+	fk_term_id = ?
+`
+
+const CreateTermRevision = `
+INSERT INTO term_revisions
+(
+	id,
+	fk_term_id,
+	revision,
+	fk_user_id,
+	name,
+	description,
+	category_ids,
+	created_at
+)
+VALUES
+(
+	?,
+	?,
+	?,
+	?,
+	?,
+	?,
+	?,
+	?
+)
+`
+
+const GetTermRevisionsByTermId = `
+SELECT
+	id, fk_term_id, revision, fk_user_id, name, COALESCE(description, ''), category_ids, created_at
+FROM
+	term_revisions
+WHERE
+	fk_term_id = ?
+ORDER BY
+	revision DESC
+`
+
+const GetTermRevision = `
+SELECT
+	id, fk_term_id, revision, fk_user_id, name, COALESCE(description, ''), category_ids, created_at
+FROM
+	term_revisions
+WHERE
+	fk_term_id = ? AND revision = ?
+`
+
+// 残す件数を超えた最初のリビジョン。これ以前を削除する
+const GetTermRevisionBeyondLimit = `
+SELECT
+	revision
+FROM
+	term_revisions
+WHERE
+	fk_term_id = ?
+ORDER BY
+	revision DESC
+LIMIT 1 OFFSET ?
+`
+
+const DeleteTermRevisionsUpTo = `
+DELETE
+FROM
+	term_revisions
+WHERE
+	fk_term_id = ? AND revision <= ?
+`
+
+// 番号が戻らないように最新のリビジョンは期限を過ぎても残す
+const DeleteTermRevisionsOlderThan = `
+DELETE
+FROM
+	term_revisions
+WHERE
+	fk_term_id = ? AND created_at < ? AND revision < ?
+`
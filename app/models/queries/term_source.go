@@ -0,0 +1,49 @@
+package queries
+
+const CreateTermSource = `
+INSERT INTO term_sources
+(
+	id,
+	fk_term_id,
+	position,
+	url,
+	domain,
+	title,
+	note,
+	captured_at
+)
+VALUES
+(
+	?,
+	?,
+	?,
+	?,
+	?,
+	?,
+	?,
+	?
+)
+`
+
+const DeleteTermSources = `
+DELETE
+FROM
+	term_sources
+WHERE
+	fk_term_id = ?
+`
+
+// GetSourcesByTermIdsBase is followed by a placeholder list "(?, ?, ...)"
+const GetSourcesByTermIdsBase = `
+SELECT
+	id, fk_term_id, url, domain, COALESCE(title, ''), COALESCE(note, ''), captured_at
+FROM
+	term_sources
+WHERE
+	fk_term_id IN
+`
+
+const GetSourcesByTermIdsOrder = `
+ORDER BY
+	fk_term_id ASC, position ASC
+`
@@ -0,0 +1,95 @@
+package queries
+
+const GetTrashedTermsByUserId = `
+SELECT
+	id, fk_user_id, name, description, status, status_changed_at, created_at, updated_at, deleted_at
+FROM
+	terms
+WHERE
+	fk_user_id = ? AND deleted_at IS NOT NULL
+ORDER BY
+	deleted_at DESC, id DESC
+`
+
+const GetTrashedCategoriesByUserId = `
+SELECT
+	id, name, fk_user_id, COALESCE(hex_color_code, ''), created_at, updated_at, deleted_at
+FROM
+	categories
+WHERE
+	fk_user_id = ? AND deleted_at IS NOT NULL
+ORDER BY
+	deleted_at DESC, id DESC
+`
+
+const GetTrashedTermById = `
+SELECT
+	id, fk_user_id, name, description, status, status_changed_at, created_at, updated_at, deleted_at
+FROM
+	terms
+WHERE
+	id = ? AND deleted_at IS NOT NULL
+`
+
+const GetTrashedCategoryById = `
+SELECT
+	id, name, fk_user_id, COALESCE(hex_color_code, ''), created_at, updated_at, deleted_at
+FROM
+	categories
+WHERE
+	id = ? AND deleted_at IS NOT NULL
+`
+
+const RestoreTerm = `
+UPDATE
+	terms
+SET
+	deleted_at = NULL
+WHERE
+	id = ? AND deleted_at IS NOT NULL
+`
+
+const RestoreCategory = `
+UPDATE
+	categories
+SET
+	deleted_at = NULL
+WHERE
+	id = ? AND deleted_at IS NOT NULL
+`
+
+// 紐付けやソース、復習の記録は外部キーで一緒に削除される
+const PurgeTerm = `
+DELETE
+FROM
+	terms
+WHERE
+	id = ? AND deleted_at IS NOT NULL
+`
+
+const PurgeCategory = `
+DELETE
+FROM
+	categories
+WHERE
+	id = ? AND deleted_at IS NOT NULL
+`
+
+// 一度に削除する行数を制限し、ロックを長く持たないようにする
+const PurgeTermsDeletedBefore = `
+DELETE
+FROM
+	terms
+WHERE
+	deleted_at IS NOT NULL AND deleted_at < ?
+LIMIT ?
+`
+
+const PurgeCategoriesDeletedBefore = `
+DELETE
+FROM
+	categories
+WHERE
+	deleted_at IS NOT NULL AND deleted_at < ?
+LIMIT ?
+`
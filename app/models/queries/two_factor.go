@@ -0,0 +1,118 @@
+package queries
+
+const UpsertPendingTOTP = `
+INSERT INTO user_totp
+(
+	fk_user_id,
+	secret,
+	created_at
+)
+VALUES
+(
+	?,
+	?,
+	?
+)
+ON DUPLICATE KEY UPDATE
+	secret = VALUES(secret),
+	confirmed_at = NULL,
+	last_used_step = 0,
+	created_at = VALUES(created_at)
+`
+
+const GetTOTPByUserIdForUpdate = `
+SELECT
+	secret, confirmed_at, last_used_step
+FROM
+	user_totp
+WHERE
+	fk_user_id = ?
+FOR UPDATE
+`
+
+const GetTOTPConfirmedAtByUserId = `
+SELECT
+	confirmed_at
+FROM
+	user_totp
+WHERE
+	fk_user_id = ?
+`
+
+const ConfirmTOTP = `
+UPDATE
+	user_totp
+SET
+	confirmed_at = ?,
+	last_used_step = ?
+WHERE
+	fk_user_id = ?
+`
+
+const UpdateTOTPLastUsedStep = `
+UPDATE
+	user_totp
+SET
+	last_used_step = ?
+WHERE
+	fk_user_id = ?
+`
+
+const DeleteTOTPByUserId = `
+DELETE FROM
+	user_totp
+WHERE
+	fk_user_id = ?
+`
+
+const CreateRecoveryCode = `
+INSERT INTO user_recovery_codes
+(
+	id,
+	fk_user_id,
+	code_hash,
+	created_at
+)
+VALUES
+(
+	?,
+	?,
+	?,
+	?
+)
+`
+
+const GetUnusedRecoveryCodeForUpdate = `
+SELECT
+	id
+FROM
+	user_recovery_codes
+WHERE
+	fk_user_id = ? AND code_hash = ? AND used_at IS NULL
+FOR UPDATE
+`
+
+const MarkRecoveryCodeUsed = `
+UPDATE
+	user_recovery_codes
+SET
+	used_at = ?
+WHERE
+	id = ?
+`
+
+const CountUnusedRecoveryCodesByUserId = `
+SELECT
+	COUNT(*)
+FROM
+	user_recovery_codes
+WHERE
+	fk_user_id = ? AND used_at IS NULL
+`
+
+const DeleteRecoveryCodesByUserId = `
+DELETE FROM
+	user_recovery_codes
+WHERE
+	fk_user_id = ?
+`
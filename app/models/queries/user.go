@@ -32,7 +32,7 @@ WHERE
 
 const GetUserById = `
 SELECT
-  name, email, created_at, updated_at
+  name, email, email_verified_at, pending_email, created_at, updated_at
 FROM
   users
 WHERE
@@ -41,9 +41,86 @@ WHERE
 
 const GetUserByEmail = `
 SELECT
-  name, id, password, created_at, updated_at
+  name, id, password, email_verified_at, created_at, updated_at
 FROM
   users
 WHERE
   email = ?
 `
+
+const UpdateUserEmailVerifiedAt = `
+UPDATE
+  users
+SET
+  email_verified_at = ?
+WHERE
+  id = ? AND email_verified_at IS NULL
+`
+
+const UpdateUserPassword = `
+UPDATE
+  users
+SET
+  password = ?,
+  updated_at = ?
+WHERE
+  id = ?
+`
+
+const GetUserPasswordById = `
+SELECT
+  password
+FROM
+  users
+WHERE
+  id = ?
+`
+
+const UpdateUserName = `
+UPDATE
+  users
+SET
+  name = ?,
+  updated_at = ?
+WHERE
+  id = ?
+`
+
+const UpdateUserPendingEmail = `
+UPDATE
+  users
+SET
+  pending_email = ?,
+  updated_at = ?
+WHERE
+  id = ?
+`
+
+const GetUserPendingEmailForUpdate = `
+SELECT
+  pending_email
+FROM
+  users
+WHERE
+  id = ?
+FOR UPDATE
+`
+
+const UpdateUserEmail = `
+UPDATE
+  users
+SET
+  email = pending_email,
+  pending_email = NULL,
+  email_verified_at = ?,
+  updated_at = ?
+WHERE
+  id = ?
+`
+
+const DeleteUser = `
+DELETE FROM
+  users
+WHERE
+  id = ?
+`
@@ -0,0 +1,68 @@
+package queries
+
+const CreateUserToken = `
+INSERT INTO user_tokens
+(
+	id,
+	fk_user_id,
+	purpose,
+	token_hash,
+	expires_at,
+	created_at
+)
+VALUES
+(
+	?,
+	?,
+	?,
+	?,
+	?,
+	?
+)
+`
+
+const GetUserTokenByHashForUpdate = `
+SELECT
+	id, fk_user_id, expires_at, used_at
+FROM
+	user_tokens
+WHERE
+	token_hash = ? AND purpose = ?
+FOR UPDATE
+`
+
+const MarkUserTokenUsed = `
+UPDATE
+	user_tokens
+SET
+	used_at = ?
+WHERE
+	id = ?
+`
+
+const MarkUserTokensUsedByUserId = `
+UPDATE
+	user_tokens
+SET
+	used_at = ?
+WHERE
+	fk_user_id = ? AND purpose = ? AND used_at IS NULL
+`
+
+const GetUserTokenByHash = `
+SELECT
+	id, fk_user_id, expires_at, used_at
+FROM
+	user_tokens
+WHERE
+	token_hash = ? AND purpose = ?
+`
+
+const PurgeUserTokensExpiredBefore = `
+DELETE
+FROM
+	user_tokens
+WHERE
+	expires_at < ?
+LIMIT ?
+`
@@ -0,0 +1,181 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/takuchi17/term-keeper/app/models/queries"
+	"github.com/takuchi17/term-keeper/pkg/srs"
+)
+
+type ReviewLogId string
+
+// DueReview is a term to review with its current schedule.
+type DueReview struct {
+	TermId          TermId
+	TermName        TermName
+	TermDescription TermDescription
+	// 一度も復習していなければ nil
+	Card *srs.Card
+}
+
+// ReviewLog is one answer of a review and the schedule it resulted in.
+type ReviewLog struct {
+	ID           ReviewLogId
+	FKTermId     TermId
+	Grade        srs.Grade
+	Ease         float64
+	IntervalDays int
+	DueAt        time.Time
+	ReviewedAt   time.Time
+}
+
+// GetDueReviews returns up to limit terms of the user to review at now, the
+// overdue ones first and then the ones never reviewed, together with the
+// number of every due term.
+func GetDueReviews(db SQLExecutor, userId TermUserId, now time.Time, limit int) ([]*DueReview, int, error) {
+	var total int
+	if err := db.QueryRow(queries.CountDueReviewsByUserId, userId, now).Scan(&total); err != nil {
+		slog.Error("Failed to count due reviews", "err", err)
+		return nil, 0, err
+	}
+
+	rows, err := db.Query(queries.GetDueReviewsByUserId, userId, now, limit)
+	if err != nil {
+		slog.Error("Failed to get due reviews", "err", err)
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	reviews := []*DueReview{}
+	for rows.Next() {
+		var (
+			review         DueReview
+			ease           sql.NullFloat64
+			intervalDays   sql.NullInt64
+			repetitions    sql.NullInt64
+			lapses         sql.NullInt64
+			dueAt          sql.NullTime
+			lastReviewedAt sql.NullTime
+		)
+		err := rows.Scan(
+			&review.TermId, &review.TermName, &review.TermDescription,
+			&ease, &intervalDays, &repetitions, &lapses, &dueAt, &lastReviewedAt,
+		)
+		if err != nil {
+			slog.Error("Failed to scan due review", "err", err)
+			return nil, 0, err
+		}
+		if dueAt.Valid {
+			review.Card = &srs.Card{
+				Ease:           ease.Float64,
+				IntervalDays:   int(intervalDays.Int64),
+				Repetitions:    int(repetitions.Int64),
+				Lapses:         int(lapses.Int64),
+				Due:            dueAt.Time,
+				LastReviewedAt: &lastReviewedAt.Time,
+			}
+		}
+		reviews = append(reviews, &review)
+	}
+	if err := rows.Err(); err != nil {
+		slog.Error("Failed to iterate due reviews", "err", err)
+		return nil, 0, err
+	}
+
+	return reviews, total, nil
+}
+
+// ReviewTerm reschedules the term by the grade of the answer and records it
+// in the history. The caller checks that the term belongs to the user.
+func ReviewTerm(db SQLExecutor, scheduler *srs.Scheduler, termId TermId, userId TermUserId, grade srs.Grade) (*srs.Card, error) {
+	var card srs.Card
+	err := WithTx(db, func(tx SQLExecutor) error {
+		current, err := getTermCardForUpdate(tx, termId)
+		if errors.Is(err, sql.ErrNoRows) {
+			current = scheduler.NewCard()
+		} else if err != nil {
+			return err
+		}
+
+		card, err = scheduler.Review(current, grade)
+		if err != nil {
+			return err
+		}
+		// DATETIME に秒未満は残らないので揃えておく
+		card.Due = card.Due.Truncate(time.Second)
+		reviewedAt := card.LastReviewedAt.Truncate(time.Second)
+		card.LastReviewedAt = &reviewedAt
+
+		_, err = tx.Exec(queries.UpsertTermReview,
+			termId, userId, card.Ease, card.IntervalDays, card.Repetitions, card.Lapses, card.Due, reviewedAt,
+		)
+		if err != nil {
+			slog.Error("Failed to save term review", "err", err)
+			return err
+		}
+		_, err = tx.Exec(queries.CreateReviewLog,
+			newULID(), userId, termId, grade, card.Ease, card.IntervalDays, card.Due, reviewedAt,
+		)
+		if err != nil {
+			slog.Error("Failed to create review log", "err", err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &card, nil
+}
+
+// GetReviewLogs returns the latest reviews of the user, only of the term when
+// termId is given.
+func GetReviewLogs(db SQLExecutor, userId TermUserId, termId *TermId, limit int) ([]*ReviewLog, error) {
+	var sb strings.Builder
+	args := []interface{}{userId}
+	sb.WriteString(queries.GetReviewLogsByUserIdBase)
+	if termId != nil {
+		sb.WriteString(queries.GetReviewLogsFilterByTermId)
+		args = append(args, *termId)
+	}
+	sb.WriteString(queries.GetReviewLogsOrder)
+	args = append(args, limit)
+
+	rows, err := db.Query(sb.String(), args...)
+	if err != nil {
+		slog.Error("Failed to get review logs", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	logs := []*ReviewLog{}
+	for rows.Next() {
+		var log ReviewLog
+		if err := rows.Scan(&log.ID, &log.FKTermId, &log.Grade, &log.Ease, &log.IntervalDays, &log.DueAt, &log.ReviewedAt); err != nil {
+			slog.Error("Failed to scan review log", "err", err)
+			return nil, err
+		}
+		logs = append(logs, &log)
+	}
+	if err := rows.Err(); err != nil {
+		slog.Error("Failed to iterate review logs", "err", err)
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+func getTermCardForUpdate(tx SQLExecutor, termId TermId) (srs.Card, error) {
+	var card srs.Card
+	err := tx.QueryRow(queries.GetTermReviewForUpdate, termId).Scan(
+		&card.Ease, &card.IntervalDays, &card.Repetitions, &card.Lapses, &card.Due, &card.LastReviewedAt,
+	)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		slog.Error("Failed to get term review", "err", err)
+	}
+	return card, err
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takuchi17/term-keeper/pkg/srs"
+)
+
+func TestReviewTerm(t *testing.T) {
+	const (
+		userId = TermUserId("01HGDJ5HXZD3K6WFYS9JU0A1XG")
+		termId = TermId("TERM006PYTH00000000000001")
+	)
+	now := time.Date(2024, 4, 1, 9, 0, 0, 0, time.Local)
+	scheduler := srs.NewScheduler()
+	scheduler.Now = func() time.Time { return now }
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	// 佐藤さんの用語はどちらもまだ復習していない
+	reviews, total, err := GetDueReviews(tx, userId, now, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, reviews, 2)
+	for _, review := range reviews {
+		assert.Nil(t, review.Card, "Never reviewed term should have no schedule")
+	}
+
+	card, err := ReviewTerm(tx, scheduler, termId, userId, srs.GradeGood)
+	require.NoError(t, err)
+	assert.Equal(t, 1, card.IntervalDays)
+	assert.Equal(t, now.AddDate(0, 0, 1), card.Due)
+
+	reviews, total, err = GetDueReviews(tx, userId, now, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total, "Reviewed term should not be due until tomorrow")
+	require.Len(t, reviews, 1)
+	assert.Equal(t, TermId("TERM007GIT000000000000001"), reviews[0].TermId)
+
+	// 翌日には予定を過ぎた用語が先に来る
+	now = now.AddDate(0, 0, 1)
+	reviews, _, err = GetDueReviews(tx, userId, now, 10)
+	require.NoError(t, err)
+	require.Len(t, reviews, 2)
+	assert.Equal(t, termId, reviews[0].TermId)
+	require.NotNil(t, reviews[0].Card)
+	assert.Equal(t, 1, reviews[0].Card.Repetitions)
+
+	card, err = ReviewTerm(tx, scheduler, termId, userId, srs.GradeGood)
+	require.NoError(t, err)
+	assert.Equal(t, 6, card.IntervalDays, "Schedule should continue from the saved one")
+	assert.Equal(t, 2, card.Repetitions)
+
+	_, err = ReviewTerm(tx, scheduler, termId, userId, "perfect")
+	assert.ErrorIs(t, err, srs.ErrInvalidGrade)
+
+	logs, err := GetReviewLogs(tx, userId, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	assert.Equal(t, 6, logs[0].IntervalDays, "Latest review should come first")
+	assert.Equal(t, srs.GradeGood, logs[0].Grade)
+	assert.Equal(t, now, logs[0].ReviewedAt)
+
+	otherTermId := TermId("TERM007GIT000000000000001")
+	logs, err = GetReviewLogs(tx, userId, &otherTermId, 10)
+	require.NoError(t, err)
+	assert.Empty(t, logs)
+}
+
+func TestGetDueReviewsLimit(t *testing.T) {
+	const userId = TermUserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	reviews, total, err := GetDueReviews(tx, userId, time.Now(), 2)
+	require.NoError(t, err)
+	assert.Len(t, reviews, 2)
+	assert.Equal(t, 5, total, "Total should count every due term")
+}
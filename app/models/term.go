@@ -23,34 +23,50 @@ type Term struct {
 	FKUserId    TermUserId
 	Name        TermName
 	Description TermDescription
-	CreatedAt   *time.Time
-	UpdatedAt   *time.Time
+	Status      TermStatus
+	// 一度も理解度を変えていなければ nil
+	StatusChangedAt *time.Time
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
 }
 
+var ErrTermNameRequired = errors.New("termname is required")
+
 type TermAndCategories struct {
 	Term       *Term
 	Categories []*Category
+	Sources    []*TermSource
 }
 
 func CreateTerm(db SQLExecutor, userId TermUserId, name TermName, description TermDescription, categoryIds []CategoryId) (*Term, error) {
 	// cheack required fields
 	if name == "" {
-		return nil, errors.New("termname is required")
+		return nil, ErrTermNameRequired
+	}
+	categoryIds = uniqueCategoryIds(categoryIds)
+	// 他のユーザーのカテゴリは紐付けられない
+	if err := CheckCategoriesOwnedByUser(db, UserId(userId), categoryIds); err != nil {
+		return nil, err
 	}
 	// generate ulid for termId
 	t := time.Now()
 	entropy := ulid.Monotonic(rand.New(rand.NewSource(t.UnixNano())), 0)
 	termId := TermId(ulid.MustNew(ulid.Timestamp(t), entropy).String())
 
-	_, err := db.Exec(queries.CreateTerm, termId, userId, name, description, t, t)
-	if err != nil {
-		slog.Error("Failed to create a term", "err", err)
-		return nil, err
-	}
+	// 用語の作成とカテゴリの紐付けは一つのトランザクションで行う
+	err := WithTx(db, func(tx SQLExecutor) error {
+		if _, err := tx.Exec(queries.CreateTerm, termId, userId, name, description, TermStatusUnread, nil, t, t); err != nil {
+			slog.Error("Failed to create a term", "err", err)
+			return err
+		}
 
-	err = LinkTermWithCategories(db, termId, categoryIds)
+		if err := LinkTermWithCategories(tx, termId, categoryIds); err != nil {
+			slog.Error("Failed to link term with categories", "err", err)
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		slog.Error("Failed to link term with categories", "err", err)
 		return nil, err
 	}
 
@@ -59,63 +75,254 @@ func CreateTerm(db SQLExecutor, userId TermUserId, name TermName, description Te
 			FKUserId:    userId,
 			Name:        name,
 			Description: description,
+			Status:      TermStatusUnread,
 			CreatedAt:   &t,
 			UpdatedAt:   &t,
 		},
 		nil
 }
 
-func GetTermsWithCategoriesByUserId(db SQLExecutor, userId TermUserId, query *string, category *string, sort *string, checked *string) ([]*TermAndCategories, error) {
-	terms, err := GetTermsByUserId(db, userId, query, category, sort, checked)
+func GetTermById(db SQLExecutor, id TermId) (*Term, error) {
+	var term Term
+	err := db.QueryRow(queries.GetTermById, id).Scan(&term.ID, &term.FKUserId, &term.Name, &term.Description, &term.Status, &term.StatusChangedAt, &term.CreatedAt, &term.UpdatedAt)
 	if err != nil {
+		slog.Error("Failed to get term by id", "err", err)
 		return nil, err
 	}
+	return &term, nil
+}
 
-	var result []*TermAndCategories
-	for _, term := range terms {
-		categoryIds, err := GetCategoryIdsByTermId(db, term.ID)
-		if err != nil {
-			return nil, err
-		}
+func GetTermWithCategoriesById(db SQLExecutor, id TermId) (*TermAndCategories, error) {
+	term, err := GetTermById(db, id)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryIds, err := GetCategoryIdsByTermId(db, term.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := GetCategoriesByIds(db, categoryIds)
+	if err != nil {
+		return nil, err
+	}
 
-		categories, err := GetCategoriesByIds(db, categoryIds)
+	sourcesByTermId, err := GetSourcesByTermIds(db, []TermId{term.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TermAndCategories{
+		Term:       term,
+		Categories: categories,
+		Sources:    sourcesByTermId[term.ID],
+	}, nil
+}
+
+const (
+	// 指定したカテゴリをすべて持つ用語 (既定)
+	CategoryMatchAll = "all"
+	// 指定したカテゴリのどれかを持つ用語
+	CategoryMatchAny = "any"
+)
+
+const (
+	// 用語名の部分一致 (既定)
+	SearchModePartial = "partial"
+	// 用語名と説明の全文検索。並び順の指定がなければ関連度順
+	SearchModeFulltext = "fulltext"
+)
+
+// TermListOptions are the filters, sort and page of a term list.
+// Nil fields are not applied.
+type TermListOptions struct {
+	Query      *string
+	SearchMode *string
+	Categories []CategoryId
+	// CategoryMatch is CategoryMatchAll or CategoryMatchAny
+	CategoryMatch *string
+	// Uncategorized matches terms without categories. It is combined with
+	// Categories by CategoryMatch like one more category.
+	Uncategorized bool
+	// SourceDomain matches terms with a source from the domain or its subdomains
+	SourceDomain *string
+	Sort         *string
+	// Checked matches understood and mastered terms with "true" and the
+	// others with "false"
+	Checked *string
+	// Statuses matches terms having one of the statuses
+	Statuses []TermStatus
+	Limit    int
+	Cursor   *string
+}
+
+func (o TermListOptions) isFulltextSearch() bool {
+	return o.SearchMode != nil && *o.SearchMode == SearchModeFulltext && o.Query != nil && *o.Query != ""
+}
+
+// TermsPage is one page of the terms of a user.
+// NextCursor is empty on the last page.
+type TermsPage struct {
+	Terms      []*TermAndCategories
+	NextCursor string
+	TotalCount int
+}
+
+// GetTermsPageWithCategoriesByUserId returns up to options.Limit terms
+// following options.Cursor in the given sort order. The cursor is the
+// NextCursor of the previous page and is only valid with the same sort.
+func GetTermsPageWithCategoriesByUserId(db SQLExecutor, userId TermUserId, options TermListOptions) (*TermsPage, error) {
+	sortOrder := getTermSortOrder(options)
+
+	var after *termCursor
+	if options.Cursor != nil && *options.Cursor != "" {
+		c, err := decodeTermCursor(*options.Cursor, sortOrder.name)
 		if err != nil {
 			return nil, err
 		}
-
-		result = append(result, &TermAndCategories{
-			Term:       term,
-			Categories: categories,
-		})
+		after = c
 	}
 
-	return result, nil
-}
+	// 件数はカーソルに関係なく絞り込み条件に一致する全件
+	var countSb strings.Builder
+	countSb.WriteString(queries.GetTermsCountBase)
+	countArgs := writeTermsFilter(&countSb, nil, userId, options)
 
-func GetTermsByUserId(db SQLExecutor, userId TermUserId, query *string, category *string, sort *string, checked *string) ([]*Term, error) {
-	var sb strings.Builder
-	var args []interface{}
+	var totalCount int
+	if err := db.QueryRow(countSb.String(), countArgs...).Scan(&totalCount); err != nil {
+		slog.Error("Failed to count terms", "err", err)
+		return nil, err
+	}
 
+	var sb strings.Builder
 	sb.WriteString(queries.GetTermsByUserIdBase)
+	args := writeTermsFilter(&sb, nil, userId, options)
+
+	offset := 0
+	switch {
+	case sortOrder.byOffset:
+		// 関連度は値で比較できないので件数でたどる
+		if after != nil {
+			offset = after.Offset
+		}
+		sb.WriteString(sortOrder.orderBy)
+		args = append(args, *options.Query)
+		// 次のページがあるかを知るために一件多く取得する
+		sb.WriteString(queries.GetTermsLimitOffset)
+		args = append(args, options.Limit+1, offset)
+	default:
+		if after != nil {
+			value, err := sortOrder.cursorValue(after.Value)
+			if err != nil {
+				return nil, err
+			}
+			sb.WriteString(sortOrder.after)
+			if sortOrder.column == "" {
+				args = append(args, after.ID)
+			} else {
+				args = append(args, value, value, after.ID)
+			}
+		}
+		sb.WriteString(sortOrder.orderBy)
+		// 次のページがあるかを知るために一件多く取得する
+		sb.WriteString(queries.GetTermsLimit)
+		args = append(args, options.Limit+1)
+	}
+
+	terms, err := queryTerms(db, sb.String(), args)
+	if err != nil {
+		return nil, err
+	}
 
-	if category != nil && *category != "" {
-		sb.WriteString(queries.GetTermsJoinWithCategory)
+	page := &TermsPage{TotalCount: totalCount}
+	if len(terms) > options.Limit {
+		terms = terms[:options.Limit]
+		page.NextCursor = encodeTermCursor(sortOrder, terms[len(terms)-1], offset+options.Limit)
 	}
 
+	termIds := make([]TermId, len(terms))
+	for i, term := range terms {
+		termIds[i] = term.ID
+	}
+	categoriesByTermId, err := GetCategoriesByTermIds(db, termIds)
+	if err != nil {
+		return nil, err
+	}
+	sourcesByTermId, err := GetSourcesByTermIds(db, termIds)
+	if err != nil {
+		return nil, err
+	}
+
+	page.Terms = make([]*TermAndCategories, len(terms))
+	for i, term := range terms {
+		page.Terms[i] = &TermAndCategories{
+			Term:       term,
+			Categories: categoriesByTermId[term.ID],
+			Sources:    sourcesByTermId[term.ID],
+		}
+	}
+
+	return page, nil
+}
+
+// writeTermsFilter writes the JOIN and WHERE clauses shared by the term list
+// queries and returns args with their values appended.
+func writeTermsFilter(sb *strings.Builder, args []interface{}, userId TermUserId, options TermListOptions) []interface{} {
 	sb.WriteString(queries.GetTermsByUserIdWhere)
 	args = append(args, userId)
 
-	if query != nil && *query != "" {
+	query := options.Query
+	if options.isFulltextSearch() {
+		sb.WriteString(queries.GetTermsFilterByFulltext)
+		args = append(args, *query)
+	} else if query != nil && *query != "" {
 		sb.WriteString(queries.GetTermsFillterByName)
 		args = append(args, "%"+*query+"%")
 	}
 
-	if category != nil && *category != "" {
-		sb.WriteString(queries.GetTermsFillterByCategory)
-		args = append(args, *category)
+	// 重複した ID を一つにまとめる
+	var categoryIds []interface{}
+	unique := make(map[CategoryId]struct{}, len(options.Categories))
+	for _, id := range options.Categories {
+		if _, ok := unique[id]; ok {
+			continue
+		}
+		unique[id] = struct{}{}
+		categoryIds = append(categoryIds, id)
+	}
+
+	// all ならすべての条件、any ならどれかの条件を満たす用語
+	var conditions []string
+	matchAny := options.CategoryMatch != nil && *options.CategoryMatch == CategoryMatchAny
+	if len(categoryIds) > 0 {
+		if matchAny {
+			conditions = append(conditions, queries.GetTermsHasAnyCategoryBase+"("+placeholders(len(categoryIds))+"))")
+			args = append(args, categoryIds...)
+		} else {
+			conditions = append(conditions, queries.GetTermsCountCategoriesBase+"("+placeholders(len(categoryIds))+")) = ?")
+			args = append(args, categoryIds...)
+			args = append(args, len(categoryIds))
+		}
+	}
+	if options.Uncategorized {
+		conditions = append(conditions, queries.GetTermsHasNoCategory)
+	}
+	if len(conditions) > 0 {
+		separator := " AND "
+		if matchAny {
+			separator = " OR "
+		}
+		sb.WriteString("\nAND (" + strings.Join(conditions, separator) + ")\n")
+	}
+
+	if options.SourceDomain != nil && *options.SourceDomain != "" {
+		domain := NormalizeSourceDomain(*options.SourceDomain)
+		sb.WriteString(queries.GetTermsFilterBySourceDomain)
+		args = append(args, domain, "%."+escapeLike(domain))
 	}
 
-	if checked != nil {
+	if checked := options.Checked; checked != nil {
 		checkedVal := strings.ToLower(*checked)
 		var checkedBoolPtr *bool
 
@@ -131,26 +338,18 @@ func GetTermsByUserId(db SQLExecutor, userId TermUserId, query *string, category
 		args = append(args, checkedBoolPtr, checkedBoolPtr, checkedBoolPtr)
 	}
 
-	// ソートの設定
-	if sort != nil {
-		switch *sort {
-		case "created_at_asc":
-			sb.WriteString(queries.GetTermsSortByCreatedAsc)
-		case "created_at_desc":
-			sb.WriteString(queries.GetTermsSortByCreatedDesc)
-		case "updated_at_asc":
-			sb.WriteString(queries.GetTermsSortByUpdatedAsc)
-		case "updated_at_desc":
-			sb.WriteString(queries.GetTermsSortByCreatedDesc)
-		case "term_asc":
-			sb.WriteString(queries.GetTermsSortByNameAsc)
-		case "term_desc":
-			sb.WriteString(queries.GetTermsSortByNameDesc)
-		default:
+	if len(options.Statuses) > 0 {
+		sb.WriteString(queries.GetTermsFilterByStatusBase + "(" + placeholders(len(options.Statuses)) + ")\n")
+		for _, status := range options.Statuses {
+			args = append(args, status)
 		}
 	}
 
-	rows, err := db.Query(sb.String(), args...)
+	return args
+}
+
+func queryTerms(db SQLExecutor, query string, args []interface{}) ([]*Term, error) {
+	rows, err := db.Query(query, args...)
 	if err != nil {
 		slog.Error("Failed to get terms", "err", err)
 		return nil, err
@@ -160,7 +359,7 @@ func GetTermsByUserId(db SQLExecutor, userId TermUserId, query *string, category
 	var terms []*Term
 	for rows.Next() {
 		var term Term
-		if err := rows.Scan(&term.ID, &term.FKUserId, &term.Name, &term.Description, &term.CreatedAt, &term.UpdatedAt); err != nil {
+		if err := rows.Scan(&term.ID, &term.FKUserId, &term.Name, &term.Description, &term.Status, &term.StatusChangedAt, &term.CreatedAt, &term.UpdatedAt); err != nil {
 			slog.Error("Failed to scan term", "err", err)
 			return nil, err
 		}
@@ -172,36 +371,43 @@ func GetTermsByUserId(db SQLExecutor, userId TermUserId, query *string, category
 
 func (t *Term) Update(db SQLExecutor, categoryIds []CategoryId) (*Term, error) {
 	if t.Name == "" {
-		return nil, errors.New("termname is required")
+		return nil, ErrTermNameRequired
 	}
-
-	_, err := db.Exec(queries.UpdateTerm, t.Name, t.Description, t.UpdatedAt, t.ID)
-	if err != nil {
-		slog.Error("Failed to update term", "err", err)
+	// 他のユーザーのカテゴリは紐付けられない
+	if err := CheckCategoriesOwnedByUser(db, UserId(t.FKUserId), categoryIds); err != nil {
 		return nil, err
 	}
 
-	err = UpdateTermCategories(db, t.ID, categoryIds)
+	err := WithTx(db, func(tx SQLExecutor) error {
+		if _, err := tx.Exec(queries.UpdateTerm, t.Name, t.Description, t.UpdatedAt, t.ID); err != nil {
+			slog.Error("Failed to update term", "err", err)
+			return err
+		}
+
+		if err := UpdateTermCategories(tx, t.ID, categoryIds); err != nil {
+			slog.Error("Failed to update term categpory relations", "err", err)
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		slog.Error("Failed to update term categpory relations", "err", err)
 		return nil, err
 	}
 
 	return t, nil
 }
 
+// Delete moves the term to the trash. Its categories, sources and reviews are
+// kept until it is restored or purged.
 func (t *Term) Delete(db SQLExecutor) error {
-	_, err := db.Exec(queries.DeleteTerm, t.ID)
-	if err != nil {
+	if _, err := db.Exec(queries.DeleteTerm, time.Now(), t.ID); err != nil {
 		slog.Error("Failed to delete term", "err", err)
 		return err
 	}
-
-	_, err = db.Exec(queries.DeleteTermCategoryRelations, t.ID)
-	if err != nil {
-		slog.Error("Failed to delete term-category relations", "err", err)
-		return err
-	}
-
 	return nil
 }
+
+// LIKE の特殊文字をエスケープする
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
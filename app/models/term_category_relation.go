@@ -27,6 +27,73 @@ func GetCategoryIdsByTermId(db SQLExecutor, termId TermId) ([]CategoryId, error)
 	return categoryIds, nil
 }
 
+// 一度の IN 検索に含める用語の数
+const termIdsBatchSize = 500
+
+// GetCategoriesByTermIds returns the categories of every given term, keyed by
+// term id. Terms are looked up in batches so the number of queries does not
+// grow with each term.
+func GetCategoriesByTermIds(db SQLExecutor, termIds []TermId) (map[TermId][]*Category, error) {
+	result := make(map[TermId][]*Category, len(termIds))
+	for _, termId := range termIds {
+		result[termId] = []*Category{}
+	}
+
+	for start := 0; start < len(termIds); start += termIdsBatchSize {
+		end := min(start+termIdsBatchSize, len(termIds))
+		batch := termIds[start:end]
+
+		query := queries.GetCategoriesByTermIdsBase + "(" + placeholders(len(batch)) + ")" + queries.GetCategoriesByTermIdsOrder
+		args := make([]interface{}, len(batch))
+		for i, v := range batch {
+			args[i] = v
+		}
+
+		rows, err := db.Query(query, args...)
+		if err != nil {
+			slog.Error("Failed to get categories by term ids", "err", err)
+			return nil, err
+		}
+
+		for rows.Next() {
+			var termId TermId
+			var category Category
+			if err := rows.Scan(&termId, &category.ID, &category.Name, &category.FKUserId, &category.HexColorCode, &category.CreatedAt, &category.UpdatedAt); err != nil {
+				rows.Close()
+				slog.Error("Failed to scan category", "err", err)
+				return nil, err
+			}
+			result[termId] = append(result[termId], &category)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			slog.Error("Failed to iterate categories", "err", err)
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// uniqueCategoryIds drops the repeated ids and keeps the order, so a request
+// that sends the same category twice links it once.
+func uniqueCategoryIds(ids []CategoryId) []CategoryId {
+	if ids == nil {
+		return nil
+	}
+	seen := make(map[CategoryId]struct{}, len(ids))
+	unique := make([]CategoryId, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
+
 func LinkTermWithCategories(db SQLExecutor, termId TermId, categoryIds []CategoryId) error {
 	for _, categoryId := range categoryIds {
 		_, err := db.Exec(queries.CreateTermCategoryRelation, termId, categoryId)
@@ -56,9 +123,12 @@ func DeleteTermCategoryRelations(db SQLExecutor, termId TermId) error {
 }
 
 func UpdateTermCategories(db SQLExecutor, termId TermId, categoryIds []CategoryId) error {
-	if err := DeleteTermCategoryRelations(db, termId); err != nil {
-		return err
-	}
+	// 削除と再作成の間で紐付けが消えたままにならないようにする
+	return WithTx(db, func(tx SQLExecutor) error {
+		if err := DeleteTermCategoryRelations(tx, termId); err != nil {
+			return err
+		}
 
-	return LinkTermWithCategories(db, termId, categoryIds)
+		return LinkTermWithCategories(tx, termId, categoryIds)
+	})
 }
@@ -0,0 +1,118 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/takuchi17/term-keeper/app/models/queries"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// termSortOrder は並び順ごとの ORDER BY とカーソル以降を取得する条件
+type termSortOrder struct {
+	name    string
+	column  string
+	orderBy string
+	after   string
+	// byOffset の並び順はカーソルに何件目まで返したかを持つ
+	byOffset bool
+}
+
+var termSortOrders = map[string]termSortOrder{
+	"created_at_asc":  {name: "created_at_asc", column: "created_at", orderBy: queries.GetTermsSortByCreatedAsc, after: queries.GetTermsAfterCreatedAsc},
+	"created_at_desc": {name: "created_at_desc", column: "created_at", orderBy: queries.GetTermsSortByCreatedDesc, after: queries.GetTermsAfterCreatedDesc},
+	"updated_at_asc":  {name: "updated_at_asc", column: "updated_at", orderBy: queries.GetTermsSortByUpdatedAsc, after: queries.GetTermsAfterUpdatedAsc},
+	"updated_at_desc": {name: "updated_at_desc", column: "updated_at", orderBy: queries.GetTermsSortByUpdatedDesc, after: queries.GetTermsAfterUpdatedDesc},
+	"term_asc":        {name: "term_asc", column: "name", orderBy: queries.GetTermsSortByNameAsc, after: queries.GetTermsAfterNameAsc},
+	"term_desc":       {name: "term_desc", column: "name", orderBy: queries.GetTermsSortByNameDesc, after: queries.GetTermsAfterNameDesc},
+}
+
+// 並び順の指定がなければ id 順 (ULID なので作成順)
+var defaultTermSortOrder = termSortOrder{name: "", column: "", orderBy: queries.GetTermsSortByIdAsc, after: queries.GetTermsAfterId}
+
+// 全文検索の関連度順。ORDER BY に検索語の引数が必要
+var relevanceTermSortOrder = termSortOrder{name: "relevance", orderBy: queries.GetTermsSortByRelevance, byOffset: true}
+
+func getTermSortOrder(options TermListOptions) termSortOrder {
+	sort := options.Sort
+	if options.isFulltextSearch() && (sort == nil || *sort == "" || *sort == relevanceTermSortOrder.name) {
+		return relevanceTermSortOrder
+	}
+	if sort == nil {
+		return defaultTermSortOrder
+	}
+	if sortOrder, ok := termSortOrders[*sort]; ok {
+		return sortOrder
+	}
+	return defaultTermSortOrder
+}
+
+// sortValue returns the value of the sort column of the term as stored in a cursor.
+func (o termSortOrder) sortValue(term *Term) string {
+	switch o.column {
+	case "created_at":
+		return term.CreatedAt.Format(time.RFC3339Nano)
+	case "updated_at":
+		return term.UpdatedAt.Format(time.RFC3339Nano)
+	case "name":
+		return string(term.Name)
+	default:
+		return ""
+	}
+}
+
+// cursorValue converts the value stored in a cursor back to a query argument.
+func (o termSortOrder) cursorValue(value string) (interface{}, error) {
+	switch o.column {
+	case "created_at", "updated_at":
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		return t, nil
+	default:
+		return value, nil
+	}
+}
+
+// termCursor is the position of the last term of a page. It is sent to the
+// client as opaque base64 and must not be relied on by it.
+type termCursor struct {
+	Sort   string `json:"s"`
+	Value  string `json:"v,omitempty"`
+	Offset int    `json:"o,omitempty"`
+	ID     TermId `json:"id"`
+}
+
+func encodeTermCursor(sortOrder termSortOrder, last *Term, offset int) string {
+	c := termCursor{
+		Sort:  sortOrder.name,
+		Value: sortOrder.sortValue(last),
+		ID:    last.ID,
+	}
+	if sortOrder.byOffset {
+		c.Offset = offset
+	}
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeTermCursor(cursor string, sort string) (*termCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c termCursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	// 別の並び順で作られたカーソルは使えない
+	if c.Sort != sort || c.ID == "" || c.Offset < 0 {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
@@ -0,0 +1,304 @@
+package models
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/takuchi17/term-keeper/app/models/queries"
+)
+
+type TermRevisionId string
+
+// TermContent is the part of a term kept in its revisions.
+type TermContent struct {
+	Name        TermName
+	Description TermDescription
+	CategoryIds []CategoryId
+}
+
+// TermRevision is the content of a term before one of its updates.
+// Revisions are numbered from 1 for each term and never change.
+type TermRevision struct {
+	TermContent
+	ID       TermRevisionId
+	FKTermId TermId
+	Revision int
+	// 更新したユーザー
+	FKUserId  UserId
+	CreatedAt time.Time
+}
+
+// TermRevisionRetention limits the revisions kept for each term.
+// A zero value keeps every revision.
+type TermRevisionRetention struct {
+	// 用語ごとに残す数。0 なら制限しない
+	MaxPerTerm int
+	// これより古いリビジョンは削除する。0 なら制限しない
+	MaxAge time.Duration
+}
+
+const (
+	TermFieldName        = "name"
+	TermFieldDescription = "description"
+	TermFieldCategoryIds = "category_ids"
+)
+
+// TermFieldDiff is the change of one field between two contents of a term.
+// Name and description set Before and After, categories set Added and Removed.
+type TermFieldDiff struct {
+	Field   string
+	Before  string
+	After   string
+	Added   []CategoryId
+	Removed []CategoryId
+}
+
+var ErrTermRevisionNotFound = errors.New("term revision not found")
+
+// Content returns the current content of the term.
+func (tc *TermAndCategories) Content() TermContent {
+	categoryIds := make([]CategoryId, len(tc.Categories))
+	for i, category := range tc.Categories {
+		categoryIds[i] = category.ID
+	}
+	return TermContent{
+		Name:        tc.Term.Name,
+		Description: tc.Term.Description,
+		CategoryIds: categoryIds,
+	}
+}
+
+// UpdateWithRevision updates the term like Update and saves the previous
+// content as a new revision of editorId when the name, the description or the
+// categories change. Revisions beyond retention are deleted afterwards.
+func (t *Term) UpdateWithRevision(db SQLExecutor, categoryIds []CategoryId, editorId UserId, retention TermRevisionRetention) (*Term, error) {
+	// 同じカテゴリが重なっていても差分にも紐付けにも一つだけ残す
+	categoryIds = uniqueCategoryIds(categoryIds)
+	err := WithTx(db, func(tx SQLExecutor) error {
+		// 同時に更新されても前の内容を取りこぼさないように行をロックする
+		var previous TermContent
+		err := tx.QueryRow(queries.GetTermContentForUpdate, t.ID).Scan(&previous.Name, &previous.Description)
+		if err != nil {
+			slog.Error("Failed to get term content", "err", err)
+			return err
+		}
+		previous.CategoryIds, err = GetCategoryIdsByTermId(tx, t.ID)
+		if err != nil {
+			return err
+		}
+
+		next := TermContent{Name: t.Name, Description: t.Description, CategoryIds: categoryIds}
+		if len(DiffTermContents(previous, next)) > 0 {
+			if err := appendTermRevision(tx, t.ID, editorId, previous, retention); err != nil {
+				return err
+			}
+		}
+
+		_, err = t.Update(tx, categoryIds)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// RestoreRevision sets the content of the term back to the revision. The
+// content before the restore becomes a new revision, so a restore can be
+// undone too. Categories deleted since the revision are left out.
+func (t *Term) RestoreRevision(db SQLExecutor, revision int, editorId UserId, retention TermRevisionRetention) (*Term, error) {
+	err := WithTx(db, func(tx SQLExecutor) error {
+		target, err := GetTermRevision(tx, t.ID, revision)
+		if err != nil {
+			return err
+		}
+
+		categories, err := GetCategoriesByIds(tx, target.CategoryIds)
+		if err != nil {
+			slog.Error("Failed to get categories of term revision", "err", err)
+			return err
+		}
+		categoryIds := []CategoryId{}
+		for _, category := range categories {
+			if UserId(category.FKUserId) == UserId(t.FKUserId) {
+				categoryIds = append(categoryIds, category.ID)
+			}
+		}
+
+		t.Name = target.Name
+		t.Description = target.Description
+		now := time.Now()
+		t.UpdatedAt = &now
+		_, err = t.UpdateWithRevision(tx, categoryIds, editorId, retention)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func appendTermRevision(tx SQLExecutor, termId TermId, editorId UserId, content TermContent, retention TermRevisionRetention) error {
+	var latest int
+	if err := tx.QueryRow(queries.GetLatestTermRevisionNumber, termId).Scan(&latest); err != nil {
+		slog.Error("Failed to get latest term revision", "err", err)
+		return err
+	}
+
+	categoryIds := content.CategoryIds
+	if categoryIds == nil {
+		categoryIds = []CategoryId{}
+	}
+	categoryIdsJSON, err := json.Marshal(categoryIds)
+	if err != nil {
+		return err
+	}
+
+	revision := latest + 1
+	now := time.Now()
+	_, err = tx.Exec(queries.CreateTermRevision, newULID(), termId, revision, editorId, content.Name, content.Description, categoryIdsJSON, now)
+	if err != nil {
+		slog.Error("Failed to create term revision", "err", err)
+		return err
+	}
+
+	return pruneTermRevisions(tx, termId, revision, now, retention)
+}
+
+func pruneTermRevisions(tx SQLExecutor, termId TermId, latest int, now time.Time, retention TermRevisionRetention) error {
+	if retention.MaxPerTerm > 0 {
+		var beyond int
+		err := tx.QueryRow(queries.GetTermRevisionBeyondLimit, termId, retention.MaxPerTerm).Scan(&beyond)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			// まだ上限に達していない
+		case err != nil:
+			slog.Error("Failed to get term revision beyond limit", "err", err)
+			return err
+		default:
+			if _, err := tx.Exec(queries.DeleteTermRevisionsUpTo, termId, beyond); err != nil {
+				slog.Error("Failed to delete term revisions beyond limit", "err", err)
+				return err
+			}
+		}
+	}
+
+	if retention.MaxAge > 0 {
+		if _, err := tx.Exec(queries.DeleteTermRevisionsOlderThan, termId, now.Add(-retention.MaxAge), latest); err != nil {
+			slog.Error("Failed to delete old term revisions", "err", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetTermRevisions returns the revisions of the term, the latest first.
+func GetTermRevisions(db SQLExecutor, termId TermId) ([]*TermRevision, error) {
+	rows, err := db.Query(queries.GetTermRevisionsByTermId, termId)
+	if err != nil {
+		slog.Error("Failed to get term revisions", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	revisions := []*TermRevision{}
+	for rows.Next() {
+		revision, err := scanTermRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	if err := rows.Err(); err != nil {
+		slog.Error("Failed to iterate term revisions", "err", err)
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// GetTermRevision returns ErrTermRevisionNotFound when the term has no such
+// revision, e.g. because it was deleted by the retention.
+func GetTermRevision(db SQLExecutor, termId TermId, revision int) (*TermRevision, error) {
+	found, err := scanTermRevision(db.QueryRow(queries.GetTermRevision, termId, revision))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTermRevisionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
+func scanTermRevision(row interface{ Scan(...any) error }) (*TermRevision, error) {
+	var (
+		revision        TermRevision
+		categoryIdsJSON []byte
+	)
+	err := row.Scan(
+		&revision.ID, &revision.FKTermId, &revision.Revision, &revision.FKUserId,
+		&revision.Name, &revision.Description, &categoryIdsJSON, &revision.CreatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if err != nil {
+		slog.Error("Failed to scan term revision", "err", err)
+		return nil, err
+	}
+	if err := json.Unmarshal(categoryIdsJSON, &revision.CategoryIds); err != nil {
+		slog.Error("Failed to decode category ids of term revision", "err", err)
+		return nil, err
+	}
+	return &revision, nil
+}
+
+// DiffTermContents returns the fields changed from before to after in the
+// order name, description and categories. The order of categories is ignored.
+func DiffTermContents(before, after TermContent) []TermFieldDiff {
+	diffs := []TermFieldDiff{}
+	if before.Name != after.Name {
+		diffs = append(diffs, TermFieldDiff{Field: TermFieldName, Before: string(before.Name), After: string(after.Name)})
+	}
+	if before.Description != after.Description {
+		diffs = append(diffs, TermFieldDiff{Field: TermFieldDescription, Before: string(before.Description), After: string(after.Description)})
+	}
+
+	added := categoryIdsMissingFrom(after.CategoryIds, before.CategoryIds)
+	removed := categoryIdsMissingFrom(before.CategoryIds, after.CategoryIds)
+	if len(added) > 0 || len(removed) > 0 {
+		diffs = append(diffs, TermFieldDiff{Field: TermFieldCategoryIds, Added: added, Removed: removed})
+	}
+
+	return diffs
+}
+
+// ids のうち others にないものを並べて返す
+func categoryIdsMissingFrom(ids []CategoryId, others []CategoryId) []CategoryId {
+	exists := make(map[CategoryId]struct{}, len(others))
+	for _, id := range others {
+		exists[id] = struct{}{}
+	}
+
+	missing := []CategoryId{}
+	seen := make(map[CategoryId]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := exists[id]; ok {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		missing = append(missing, id)
+	}
+	sort.Slice(missing, func(i, j int) bool { return missing[i] < missing[j] })
+	return missing
+}
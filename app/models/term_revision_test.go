@@ -0,0 +1,217 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTermUpdateWithRevision(t *testing.T) {
+	const (
+		userId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+		termId = TermId("TERM001SQL000000000000001")
+		dbs    = CategoryId("CATE002DBS0000000000000001")
+		prog   = CategoryId("CATE001PROG000000000000001")
+	)
+	original := "Structured Query Language。リレーショナルデータベースの操作に使用される言語。"
+
+	testCases := []struct {
+		name              string
+		description       TermDescription
+		categoryIds       []CategoryId
+		expectedRevisions int
+	}{
+		{
+			name:              "Description changed",
+			description:       "問い合わせ言語",
+			categoryIds:       []CategoryId{dbs},
+			expectedRevisions: 1,
+		},
+		{
+			name:              "Categories changed",
+			description:       TermDescription(original),
+			categoryIds:       []CategoryId{dbs, prog},
+			expectedRevisions: 1,
+		},
+		{
+			name:              "Duplicated category ids are linked once",
+			description:       TermDescription(original),
+			categoryIds:       []CategoryId{dbs, dbs},
+			expectedRevisions: 0,
+		},
+		{
+			name:              "Nothing changed",
+			description:       TermDescription(original),
+			categoryIds:       []CategoryId{dbs},
+			expectedRevisions: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+
+			term, err := GetTermById(tx, termId)
+			require.NoError(t, err)
+			term.Description = tc.description
+
+			_, err = term.UpdateWithRevision(tx, tc.categoryIds, userId, TermRevisionRetention{})
+			require.NoError(t, err)
+
+			revisions, err := GetTermRevisions(tx, termId)
+			require.NoError(t, err)
+			require.Len(t, revisions, tc.expectedRevisions)
+			if tc.expectedRevisions == 0 {
+				return
+			}
+
+			// 更新する前の内容が残る
+			assert.Equal(t, 1, revisions[0].Revision)
+			assert.Equal(t, userId, revisions[0].FKUserId)
+			assert.Equal(t, TermName("SQL"), revisions[0].Name)
+			assert.Equal(t, TermDescription(original), revisions[0].Description)
+			assert.Equal(t, []CategoryId{dbs}, revisions[0].CategoryIds)
+		})
+	}
+}
+
+func TestTermRestoreRevision(t *testing.T) {
+	const (
+		userId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+		termId = TermId("TERM001SQL000000000000001")
+		dbs    = CategoryId("CATE002DBS0000000000000001")
+		net    = CategoryId("CATE003NET0000000000000001")
+	)
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	term, err := GetTermById(tx, termId)
+	require.NoError(t, err)
+	term.Name = "SQL (edited)"
+	term.Description = "間違えて書き換えた"
+	_, err = term.UpdateWithRevision(tx, []CategoryId{net}, userId, TermRevisionRetention{})
+	require.NoError(t, err)
+
+	_, err = term.RestoreRevision(tx, 1, userId, TermRevisionRetention{})
+	require.NoError(t, err)
+
+	restored, err := GetTermWithCategoriesById(tx, termId)
+	require.NoError(t, err)
+	assert.Equal(t, TermName("SQL"), restored.Term.Name)
+	assert.Equal(t, []CategoryId{dbs}, restored.Content().CategoryIds)
+
+	// 復元する前の内容も新しいリビジョンとして残る
+	revisions, err := GetTermRevisions(tx, termId)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, 2, revisions[0].Revision)
+	assert.Equal(t, TermName("SQL (edited)"), revisions[0].Name)
+	assert.Equal(t, []CategoryId{net}, revisions[0].CategoryIds)
+
+	_, err = term.RestoreRevision(tx, 5, userId, TermRevisionRetention{})
+	assert.ErrorIs(t, err, ErrTermRevisionNotFound)
+}
+
+func TestTermRevisionRetention(t *testing.T) {
+	const (
+		userId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+		termId = TermId("TERM002TCP000000000000001")
+		net    = CategoryId("CATE003NET0000000000000001")
+	)
+
+	testCases := []struct {
+		name              string
+		retention         TermRevisionRetention
+		expectedRevisions []int
+	}{
+		{
+			name:              "Unlimited",
+			retention:         TermRevisionRetention{},
+			expectedRevisions: []int{4, 3, 2, 1},
+		},
+		{
+			name:              "Latest two",
+			retention:         TermRevisionRetention{MaxPerTerm: 2},
+			expectedRevisions: []int{4, 3},
+		},
+		{
+			name:              "Latest is kept after max age",
+			retention:         TermRevisionRetention{MaxAge: time.Nanosecond},
+			expectedRevisions: []int{4},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+
+			term, err := GetTermById(tx, termId)
+			require.NoError(t, err)
+			for _, description := range []TermDescription{"1", "2", "3", "4"} {
+				term.Description = description
+				_, err := term.UpdateWithRevision(tx, []CategoryId{net}, userId, tc.retention)
+				require.NoError(t, err)
+				// created_at は秒単位なので期限切れを確かめられるように間をあける
+				if tc.retention.MaxAge > 0 {
+					time.Sleep(time.Second)
+				}
+			}
+
+			revisions, err := GetTermRevisions(tx, termId)
+			require.NoError(t, err)
+			actual := []int{}
+			for _, revision := range revisions {
+				actual = append(actual, revision.Revision)
+			}
+			assert.Equal(t, tc.expectedRevisions, actual)
+		})
+	}
+}
+
+func TestDiffTermContents(t *testing.T) {
+	testCases := []struct {
+		name     string
+		before   TermContent
+		after    TermContent
+		expected []TermFieldDiff
+	}{
+		{
+			name:     "Same content",
+			before:   TermContent{Name: "Go", Description: "言語", CategoryIds: []CategoryId{"a", "b"}},
+			after:    TermContent{Name: "Go", Description: "言語", CategoryIds: []CategoryId{"b", "a"}},
+			expected: []TermFieldDiff{},
+		},
+		{
+			name:   "Every field changed",
+			before: TermContent{Name: "Go", Description: "言語", CategoryIds: []CategoryId{"a", "b"}},
+			after:  TermContent{Name: "Golang", Description: "", CategoryIds: []CategoryId{"c", "b"}},
+			expected: []TermFieldDiff{
+				{Field: TermFieldName, Before: "Go", After: "Golang"},
+				{Field: TermFieldDescription, Before: "言語", After: ""},
+				{Field: TermFieldCategoryIds, Added: []CategoryId{"c"}, Removed: []CategoryId{"a"}},
+			},
+		},
+		{
+			name:   "Categories only added",
+			before: TermContent{Name: "Go"},
+			after:  TermContent{Name: "Go", CategoryIds: []CategoryId{"b", "a"}},
+			expected: []TermFieldDiff{
+				{Field: TermFieldCategoryIds, Added: []CategoryId{"a", "b"}, Removed: []CategoryId{}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, DiffTermContents(tc.before, tc.after))
+		})
+	}
+}
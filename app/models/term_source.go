@@ -0,0 +1,196 @@
+package models
+
+import (
+	"errors"
+	"log/slog"
+	"math/rand"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/takuchi17/term-keeper/app/models/queries"
+)
+
+type (
+	TermSourceId    string
+	TermSourceURL   string
+	TermSourceTitle string
+	TermSourceNote  string
+)
+
+type TermSource struct {
+	ID         TermSourceId
+	FKTermId   TermId
+	URL        TermSourceURL
+	Domain     string
+	Title      TermSourceTitle
+	Note       TermSourceNote
+	CapturedAt *time.Time
+}
+
+var (
+	ErrInvalidSourceURL = errors.New("source url must be an absolute http or https url")
+	ErrTooManySources   = errors.New("too many sources")
+	ErrSourceTooLong    = errors.New("source title or note is too long")
+)
+
+// term_sources のカラムの長さ
+const (
+	maxSourcesPerTerm    = 20
+	maxSourceURLLength   = 2048
+	maxSourceTitleLength = 255
+	maxSourceNoteLength  = 1000
+)
+
+// NormalizeSourceURL validates the url and returns it in a canonical form
+// together with its domain. Scheme and host are lowercased, default ports and
+// fragments are removed, and the domain does not have a leading "www.".
+func NormalizeSourceURL(rawURL string) (TermSourceURL, string, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return "", "", ErrInvalidSourceURL
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", "", ErrInvalidSourceURL
+	}
+	if u.Hostname() == "" || u.User != nil {
+		return "", "", ErrInvalidSourceURL
+	}
+
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+	if (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		port = ""
+	}
+	if port != "" {
+		u.Host = net.JoinHostPort(host, port)
+	} else if strings.Contains(host, ":") {
+		// IPv6
+		u.Host = "[" + host + "]"
+	} else {
+		u.Host = host
+	}
+	u.Fragment = ""
+	u.RawFragment = ""
+	if u.Path == "" {
+		u.Path = "/"
+	}
+
+	normalized := u.String()
+	if len(normalized) > maxSourceURLLength {
+		return "", "", ErrInvalidSourceURL
+	}
+
+	return TermSourceURL(normalized), NormalizeSourceDomain(host), nil
+}
+
+// NormalizeSourceDomain lowercases the domain and removes a leading "www." so
+// that "www.example.com" and "example.com" are the same site.
+func NormalizeSourceDomain(domain string) string {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	domain = strings.TrimSuffix(domain, ".")
+	return strings.TrimPrefix(domain, "www.")
+}
+
+// ReplaceTermSources replaces every source of the term with the given ones,
+// kept in the given order. URLs are normalized before they are stored.
+func ReplaceTermSources(db SQLExecutor, termId TermId, sources []*TermSource) error {
+	if len(sources) > maxSourcesPerTerm {
+		return ErrTooManySources
+	}
+	for _, source := range sources {
+		normalized, domain, err := NormalizeSourceURL(string(source.URL))
+		if err != nil {
+			return err
+		}
+		if utf8.RuneCountInString(string(source.Title)) > maxSourceTitleLength || utf8.RuneCountInString(string(source.Note)) > maxSourceNoteLength {
+			return ErrSourceTooLong
+		}
+		source.URL = normalized
+		source.Domain = domain
+		source.FKTermId = termId
+	}
+
+	return WithTx(db, func(tx SQLExecutor) error {
+		if _, err := tx.Exec(queries.DeleteTermSources, termId); err != nil {
+			slog.Error("Failed to delete term sources", "err", err)
+			return err
+		}
+
+		t := time.Now()
+		entropy := ulid.Monotonic(rand.New(rand.NewSource(t.UnixNano())), 0)
+		for i, source := range sources {
+			source.ID = TermSourceId(ulid.MustNew(ulid.Timestamp(t), entropy).String())
+			// 取得日時の指定がなければ登録した日時にする
+			if source.CapturedAt == nil {
+				source.CapturedAt = &t
+			}
+			_, err := tx.Exec(queries.CreateTermSource,
+				source.ID, termId, i, source.URL, source.Domain,
+				nullableString(string(source.Title)), nullableString(string(source.Note)), source.CapturedAt,
+			)
+			if err != nil {
+				slog.Error("Failed to create term source", "err", err)
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetSourcesByTermIds returns the sources of every given term, keyed by term
+// id, in batches like GetCategoriesByTermIds.
+func GetSourcesByTermIds(db SQLExecutor, termIds []TermId) (map[TermId][]*TermSource, error) {
+	result := make(map[TermId][]*TermSource, len(termIds))
+	for _, termId := range termIds {
+		result[termId] = []*TermSource{}
+	}
+
+	for start := 0; start < len(termIds); start += termIdsBatchSize {
+		end := min(start+termIdsBatchSize, len(termIds))
+		batch := termIds[start:end]
+
+		query := queries.GetSourcesByTermIdsBase + "(" + placeholders(len(batch)) + ")" + queries.GetSourcesByTermIdsOrder
+		args := make([]interface{}, len(batch))
+		for i, v := range batch {
+			args[i] = v
+		}
+
+		rows, err := db.Query(query, args...)
+		if err != nil {
+			slog.Error("Failed to get sources by term ids", "err", err)
+			return nil, err
+		}
+
+		for rows.Next() {
+			var source TermSource
+			if err := rows.Scan(&source.ID, &source.FKTermId, &source.URL, &source.Domain, &source.Title, &source.Note, &source.CapturedAt); err != nil {
+				rows.Close()
+				slog.Error("Failed to scan term source", "err", err)
+				return nil, err
+			}
+			result[source.FKTermId] = append(result[source.FKTermId], &source)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			slog.Error("Failed to iterate term sources", "err", err)
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// 空文字は NULL として保存する
+func nullableString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
@@ -0,0 +1,108 @@
+package models
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/takuchi17/term-keeper/app/models/queries"
+)
+
+// TermStatus is how well the user knows the term.
+type TermStatus string
+
+const (
+	// まだ調べていない (既定)
+	TermStatusUnread TermStatus = "unread"
+	// 調べている途中
+	TermStatusResearching TermStatus = "researching"
+	// 意味を確認した
+	TermStatusUnderstood TermStatus = "understood"
+	// 説明できるほど身についた
+	TermStatusMastered TermStatus = "mastered"
+)
+
+var ErrInvalidTermStatus = errors.New("invalid term status")
+
+// IsValid reports whether s is one of the known statuses.
+func (s TermStatus) IsValid() bool {
+	switch s {
+	case TermStatusUnread, TermStatusResearching, TermStatusUnderstood, TermStatusMastered:
+		return true
+	default:
+		return false
+	}
+}
+
+type TermStatusTransitionId string
+
+// TermStatusTransition is one change of the status of a term.
+type TermStatusTransition struct {
+	ID         TermStatusTransitionId
+	FKTermId   TermId
+	FromStatus TermStatus
+	ToStatus   TermStatus
+	ChangedAt  time.Time
+}
+
+// ChangeStatus sets the status of the term and records the transition.
+// Nothing is written when the term already has the status.
+func (t *Term) ChangeStatus(db SQLExecutor, status TermStatus) error {
+	if !status.IsValid() {
+		return ErrInvalidTermStatus
+	}
+
+	return WithTx(db, func(tx SQLExecutor) error {
+		// 同時に変更されても履歴の from が実際の値になるように行をロックする
+		var current TermStatus
+		if err := tx.QueryRow(queries.GetTermStatusForUpdate, t.ID).Scan(&current); err != nil {
+			slog.Error("Failed to get term status", "err", err)
+			return err
+		}
+		if current == status {
+			t.Status = current
+			return nil
+		}
+
+		now := time.Now()
+		if _, err := tx.Exec(queries.UpdateTermStatus, status, now, t.ID); err != nil {
+			slog.Error("Failed to update term status", "err", err)
+			return err
+		}
+		if _, err := tx.Exec(queries.CreateTermStatusTransition, newULID(), t.ID, current, status, now); err != nil {
+			slog.Error("Failed to create term status transition", "err", err)
+			return err
+		}
+
+		t.Status = status
+		t.StatusChangedAt = &now
+		return nil
+	})
+}
+
+// GetTermStatusTransitions returns the status changes of the term, the latest first.
+func GetTermStatusTransitions(db SQLExecutor, termId TermId) ([]*TermStatusTransition, error) {
+	rows, err := db.Query(queries.GetTermStatusTransitionsByTermId, termId)
+	if err != nil {
+		slog.Error("Failed to get term status transitions", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	transitions := []*TermStatusTransition{}
+	for rows.Next() {
+		var transition TermStatusTransition
+		err := rows.Scan(&transition.ID, &transition.FKTermId, &transition.FromStatus, &transition.ToStatus, &transition.ChangedAt)
+		if err != nil {
+			slog.Error("Failed to scan term status transition", "err", err)
+			return nil, err
+		}
+		transitions = append(transitions, &transition)
+	}
+	if err := rows.Err(); err != nil {
+		slog.Error("Failed to iterate term status transitions", "err", err)
+		return nil, err
+	}
+
+	return transitions, nil
+}
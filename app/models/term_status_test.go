@@ -0,0 +1,138 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takuchi17/term-keeper/pkg/util"
+)
+
+func TestTermChangeStatus(t *testing.T) {
+	testCases := []struct {
+		name                string
+		termId              TermId
+		statuses            []TermStatus
+		expectedStatus      TermStatus
+		expectedTransitions [][2]TermStatus
+		expectedErr         error
+	}{
+		{
+			name:                "Unread to researching",
+			termId:              "TERM004AWS000000000000001",
+			statuses:            []TermStatus{TermStatusResearching},
+			expectedStatus:      TermStatusResearching,
+			expectedTransitions: [][2]TermStatus{{TermStatusUnread, TermStatusResearching}},
+		},
+		{
+			name:           "Several changes are recorded in order",
+			termId:         "TERM004AWS000000000000001",
+			statuses:       []TermStatus{TermStatusResearching, TermStatusUnderstood, TermStatusMastered},
+			expectedStatus: TermStatusMastered,
+			// 新しい変更から返る
+			expectedTransitions: [][2]TermStatus{
+				{TermStatusUnderstood, TermStatusMastered},
+				{TermStatusResearching, TermStatusUnderstood},
+				{TermStatusUnread, TermStatusResearching},
+			},
+		},
+		{
+			name:                "Same status is not recorded",
+			termId:              "TERM001SQL000000000000001",
+			statuses:            []TermStatus{TermStatusUnderstood},
+			expectedStatus:      TermStatusUnderstood,
+			expectedTransitions: [][2]TermStatus{},
+		},
+		{
+			name:                "Invalid status",
+			termId:              "TERM001SQL000000000000001",
+			statuses:            []TermStatus{"checked"},
+			expectedStatus:      TermStatusUnderstood,
+			expectedTransitions: [][2]TermStatus{},
+			expectedErr:         ErrInvalidTermStatus,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+
+			term, err := GetTermById(tx, tc.termId)
+			require.NoError(t, err)
+
+			for _, status := range tc.statuses {
+				err = term.ChangeStatus(tx, status)
+			}
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			stored, err := GetTermById(tx, tc.termId)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, stored.Status, "Status mismatch")
+
+			transitions, err := GetTermStatusTransitions(tx, tc.termId)
+			require.NoError(t, err)
+			actual := [][2]TermStatus{}
+			for _, transition := range transitions {
+				actual = append(actual, [2]TermStatus{transition.FromStatus, transition.ToStatus})
+			}
+			assert.Equal(t, tc.expectedTransitions, actual, "Transitions mismatch")
+		})
+	}
+}
+
+func TestGetTermsPageWithStatusFilter(t *testing.T) {
+	const userId = "01HGDJ5GZRJ2J5VEXR8HT8V9WF"
+
+	testCases := []struct {
+		name          string
+		statuses      []TermStatus
+		checked       *string
+		expectedNames []TermName
+	}{
+		{
+			name:          "One status",
+			statuses:      []TermStatus{TermStatusUnread},
+			expectedNames: []TermName{"AWS", "TLS"},
+		},
+		{
+			name:          "Any of two statuses",
+			statuses:      []TermStatus{TermStatusResearching, TermStatusMastered},
+			expectedNames: []TermName{"Docker", "TCP/IP"},
+		},
+		{
+			name:          "Checked is understood or mastered",
+			checked:       util.Ptr("true"),
+			expectedNames: []TermName{"Docker", "SQL"},
+		},
+		{
+			name:          "Not checked",
+			checked:       util.Ptr("false"),
+			expectedNames: []TermName{"AWS", "TCP/IP", "TLS"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			page, err := GetTermsPageWithCategoriesByUserId(DB, userId, TermListOptions{
+				Statuses: tc.statuses,
+				Checked:  tc.checked,
+				Sort:     util.Ptr("term_asc"),
+				Limit:    20,
+			})
+			require.NoError(t, err)
+
+			names := []TermName{}
+			for _, term := range page.Terms {
+				names = append(names, term.Term.Name)
+			}
+			assert.Equal(t, tc.expectedNames, names, "Filtered terms mismatch")
+			assert.Equal(t, len(tc.expectedNames), page.TotalCount, "Total count mismatch")
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package models
 
 import (
+	"database/sql"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -42,6 +45,14 @@ func TestCreateTerm(t *testing.T) {
 			categoryIds: []CategoryId{},
 			wantErr:     false,
 		},
+		{
+			name:        "Category owned by another user",
+			userId:      "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			termName:    "Kubernetes",
+			description: "Container orchestration.",
+			categoryIds: []CategoryId{"CATE004ML00000000000000001"},
+			wantErr:     true,
+		},
 		{
 			name:        "Empty term name",
 			userId:      "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
@@ -132,7 +143,7 @@ func TestCreateTerm(t *testing.T) {
 	}
 }
 
-func TestGetTermsByUserId(t *testing.T) {
+func TestGetTermsPageWithFilters(t *testing.T) {
 	testCases := []struct {
 		name          string
 		userId        TermUserId
@@ -206,27 +217,32 @@ func TestGetTermsByUserId(t *testing.T) {
 			tx, err := DB.Begin()
 			require.NoError(t, err)
 			defer tx.Rollback()
-			terms, err := GetTermsByUserId(tx, tc.userId, tc.query, tc.category, tc.sort, tc.checked)
+			options := TermListOptions{Query: tc.query, Sort: tc.sort, Checked: tc.checked, Limit: 100}
+			if tc.category != nil {
+				options.Categories = []CategoryId{CategoryId(*tc.category)}
+			}
+			page, err := GetTermsPageWithCategoriesByUserId(tx, tc.userId, options)
 
 			if tc.wantErr {
 				assert.Error(t, err, "Expected error, but an error did not occur.")
 				return
 			}
 
-			assert.NoError(t, err, "Expected no error, but an error occurred.")
+			require.NoError(t, err, "Expected no error, but an error occurred.")
+			terms := page.Terms
 			assert.Len(t, terms, tc.expectedCount, "Unexpected number of terms returned")
 
 			if tc.expectedCount > 0 {
 				// If sorting is specified, check that the order matches expected
 				if tc.sort != nil {
 					for i, expectedTerm := range tc.expectedTerms {
-						assert.Equal(t, TermName(expectedTerm), terms[i].Name, "Term at position %d doesn't match expected", i)
+						assert.Equal(t, TermName(expectedTerm), terms[i].Term.Name, "Term at position %d doesn't match expected", i)
 					}
 				} else {
 					// Otherwise just check that all expected terms are present
 					var termNames []string
 					for _, term := range terms {
-						termNames = append(termNames, string(term.Name))
+						termNames = append(termNames, string(term.Term.Name))
 					}
 
 					for _, expectedTerm := range tc.expectedTerms {
@@ -238,7 +254,7 @@ func TestGetTermsByUserId(t *testing.T) {
 	}
 }
 
-func TestGetTermsWithCategoriesByUserId(t *testing.T) {
+func TestGetTermsPageLoadsCategories(t *testing.T) {
 	testCases := []struct {
 		name          string
 		userId        TermUserId
@@ -282,14 +298,19 @@ func TestGetTermsWithCategoriesByUserId(t *testing.T) {
 			tx, err := DB.Begin()
 			require.NoError(t, err)
 			defer tx.Rollback()
-			termAndCategories, err := GetTermsWithCategoriesByUserId(tx, tc.userId, tc.query, tc.category, tc.sort, tc.checked)
+			options := TermListOptions{Query: tc.query, Sort: tc.sort, Checked: tc.checked, Limit: 100}
+			if tc.category != nil {
+				options.Categories = []CategoryId{CategoryId(*tc.category)}
+			}
+			page, err := GetTermsPageWithCategoriesByUserId(tx, tc.userId, options)
 
 			if tc.wantErr {
 				assert.Error(t, err, "Expected error, but an error did not occur.")
 				return
 			}
 
-			assert.NoError(t, err, "Expected no error, but an error occurred.")
+			require.NoError(t, err, "Expected no error, but an error occurred.")
+			termAndCategories := page.Terms
 			assert.Len(t, termAndCategories, tc.expectedCount, "Unexpected number of terms returned")
 
 			if tc.expectedCount > 0 {
@@ -311,6 +332,64 @@ func TestGetTermsWithCategoriesByUserId(t *testing.T) {
 	}
 }
 
+func TestGetTermWithCategoriesById(t *testing.T) {
+	testCases := []struct {
+		name         string
+		termId       TermId
+		expectedUser TermUserId
+		expectedTerm string
+		expectedCats []string
+		wantErr      bool
+	}{
+		{
+			name:         "Term with one category",
+			termId:       "TERM001SQL000000000000001",
+			expectedUser: "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			expectedTerm: "SQL",
+			expectedCats: []string{"データベース"},
+			wantErr:      false,
+		},
+		{
+			name:         "Term with multiple categories",
+			termId:       "TERM006PYTH00000000000001",
+			expectedUser: "01HGDJ5HXZD3K6WFYS9JU0A1XG",
+			expectedTerm: "Python",
+			expectedCats: []string{"プログラミング", "機械学習"},
+			wantErr:      false,
+		},
+		{
+			name:    "Non-existent term",
+			termId:  "NONEXISTENTTERMID00000000",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+			termAndCategories, err := GetTermWithCategoriesById(tx, tc.termId)
+
+			if tc.wantErr {
+				assert.ErrorIs(t, err, sql.ErrNoRows, "Expected sql.ErrNoRows for missing term")
+				return
+			}
+
+			assert.NoError(t, err, "Expected no error, but an error occurred.")
+			assert.Equal(t, tc.termId, termAndCategories.Term.ID, "Term ID mismatch")
+			assert.Equal(t, tc.expectedUser, termAndCategories.Term.FKUserId, "User ID mismatch")
+			assert.Equal(t, TermName(tc.expectedTerm), termAndCategories.Term.Name, "Term name mismatch")
+
+			var categoryNames []string
+			for _, cat := range termAndCategories.Categories {
+				categoryNames = append(categoryNames, string(cat.Name))
+			}
+			assert.ElementsMatch(t, tc.expectedCats, categoryNames, "Category mismatch")
+		})
+	}
+}
+
 func TestTermUpdate(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -333,9 +412,17 @@ func TestTermUpdate(t *testing.T) {
 			termId:         "TERM002TCP000000000000001",
 			newName:        "TCP/IP",
 			newDescription: "インターネット通信の基盤となるプロトコル群。",
-			newCategories:  []CategoryId{"CATE003NET0000000000000001", "CATE006SEC0000000000000001"},
+			newCategories:  []CategoryId{"CATE003NET0000000000000001", "CATE001PROG000000000000001"},
 			wantErr:        false,
 		},
+		{
+			name:           "Category owned by another user",
+			termId:         "TERM002TCP000000000000001",
+			newName:        "TCP/IP",
+			newDescription: "インターネット通信の基盤となるプロトコル群。",
+			newCategories:  []CategoryId{"CATE006SEC0000000000000001"},
+			wantErr:        true,
+		},
 		{
 			name:           "Empty term name",
 			termId:         "TERM003DOCK00000000000001",
@@ -472,22 +559,25 @@ func TestTermDelete(t *testing.T) {
 
 			assert.NoError(t, err, "Expected no error, but an error occurred.")
 
-			// Verify term is deleted from database
+			// Verify term is moved to the trash
+			_, err = GetTermById(tx, tc.termId)
+			assert.ErrorIs(t, err, sql.ErrNoRows, "Deleted term should not be found")
+
 			var count int
 			err = tx.QueryRow(`
-				SELECT COUNT(*) FROM terms WHERE id = ?
+				SELECT COUNT(*) FROM terms WHERE id = ? AND deleted_at IS NOT NULL
 			`, tc.termId).Scan(&count)
 
 			assert.NoError(t, err, "Error counting terms")
-			assert.Equal(t, 0, count, "Term should be deleted")
+			assert.Equal(t, 1, count, "Term should be in the trash")
 
-			// Verify category relations are deleted
+			// Verify category relations are kept for restoring
 			err = tx.QueryRow(`
 				SELECT COUNT(*) FROM term_category_relations WHERE fk_term_id = ?
 			`, tc.termId).Scan(&count)
 
 			assert.NoError(t, err, "Error counting term category relations")
-			assert.Equal(t, 0, count, "Term category relations should be deleted")
+			assert.NotZero(t, count, "Term category relations should be kept")
 		})
 	}
 }
@@ -496,3 +586,681 @@ func TestTermDelete(t *testing.T) {
 func stringPtr(s string) *string {
 	return &s
 }
+
+func TestWithTx(t *testing.T) {
+	const termId = "TERMTX0000000000000000001"
+	const userId = "01HGDJ5GZRJ2J5VEXR8HT8V9WF"
+
+	testCases := []struct {
+		name      string
+		useTx     bool
+		fnErr     error
+		wantExist bool
+	}{
+		{
+			name:      "Commit inside an outer transaction",
+			useTx:     true,
+			fnErr:     nil,
+			wantExist: true,
+		},
+		{
+			name:      "Rollback inside an outer transaction",
+			useTx:     true,
+			fnErr:     errors.New("failed on purpose"),
+			wantExist: false,
+		},
+		{
+			name:      "Rollback of a new transaction",
+			useTx:     false,
+			fnErr:     errors.New("failed on purpose"),
+			wantExist: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+
+			var db SQLExecutor = DB
+			if tc.useTx {
+				db = tx
+			}
+
+			now := time.Now()
+			err = WithTx(db, func(tx SQLExecutor) error {
+				_, err := tx.Exec(`
+					INSERT INTO terms (id, fk_user_id, name, description, created_at, updated_at)
+					VALUES (?, ?, ?, ?, ?, ?)
+				`, termId, userId, "Transaction", "", now, now)
+				require.NoError(t, err)
+				return tc.fnErr
+			})
+
+			if tc.fnErr != nil {
+				assert.ErrorIs(t, err, tc.fnErr, "The error of fn should be returned")
+			} else {
+				assert.NoError(t, err, "Expected no error, but an error occurred.")
+			}
+
+			var count int
+			err = db.QueryRow(`SELECT COUNT(*) FROM terms WHERE id = ?`, termId).Scan(&count)
+			require.NoError(t, err)
+			if tc.wantExist {
+				assert.Equal(t, 1, count, "Term should be committed")
+			} else {
+				assert.Equal(t, 0, count, "Term should be rolled back")
+			}
+		})
+	}
+}
+
+// カテゴリの紐付けに失敗したら用語も作成されないことのテスト
+func TestCreateTermRollbackOnLinkFailure(t *testing.T) {
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	var before int
+	err = tx.QueryRow(`SELECT COUNT(*) FROM terms WHERE name = ?`, "Rust").Scan(&before)
+	require.NoError(t, err)
+
+	// 用語を作ってからカテゴリの紐付けで失敗させる
+	defer blockCategoryLink(t, tx, "CATE001PROG000000000000001")()
+	term, err := CreateTerm(tx, "01HGDJ5GZRJ2J5VEXR8HT8V9WF", "Rust", "A systems programming language.",
+		[]CategoryId{"CATE001PROG000000000000001"})
+	assert.Error(t, err, "Expected error, but an error did not occur.")
+	assert.Nil(t, term, "Term should be nil")
+
+	var after int
+	err = tx.QueryRow(`SELECT COUNT(*) FROM terms WHERE name = ?`, "Rust").Scan(&after)
+	require.NoError(t, err)
+	assert.Equal(t, before, after, "Term should not be left half-created")
+
+	var relations int
+	err = tx.QueryRow(`
+		SELECT COUNT(*) FROM term_category_relations r
+		LEFT JOIN terms t ON r.fk_term_id = t.id
+		WHERE t.id IS NULL
+	`).Scan(&relations)
+	require.NoError(t, err)
+	assert.Equal(t, 0, relations, "No dangling relations should be left")
+}
+
+// blockCategoryLink locks the category from another transaction so linking it
+// in tx fails with a lock wait timeout. Defer the returned func before tx is
+// rolled back to restore the timeout of the connection.
+func blockCategoryLink(t *testing.T, tx *sql.Tx, categoryId CategoryId) func() {
+	t.Helper()
+
+	locker, err := DB.Begin()
+	require.NoError(t, err)
+	var id CategoryId
+	err = locker.QueryRow(`SELECT id FROM categories WHERE id = ? FOR UPDATE`, categoryId).Scan(&id)
+	require.NoError(t, err)
+
+	_, err = tx.Exec(`SET SESSION innodb_lock_wait_timeout = 1`)
+	require.NoError(t, err)
+
+	return func() {
+		tx.Exec(`SET SESSION innodb_lock_wait_timeout = DEFAULT`)
+		locker.Rollback()
+	}
+}
+
+// 同じカテゴリの ID を二度送っても紐付けは一つになることのテスト
+func TestCreateTermWithDuplicatedCategoryIds(t *testing.T) {
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	term, err := CreateTerm(tx, "01HGDJ5GZRJ2J5VEXR8HT8V9WF", "Rust", "A systems programming language.",
+		[]CategoryId{"CATE001PROG000000000000001", "CATE001PROG000000000000001"})
+	require.NoError(t, err)
+
+	categoryIds, err := GetCategoryIdsByTermId(tx, term.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []CategoryId{"CATE001PROG000000000000001"}, categoryIds)
+}
+
+// カテゴリの再作成に失敗したら用語も紐付けも元のままであることのテスト
+func TestTermUpdateRollbackOnLinkFailure(t *testing.T) {
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	original, err := GetTermWithCategoriesById(tx, "TERM001SQL000000000000001")
+	require.NoError(t, err)
+
+	term := *original.Term
+	term.Name = "SQL (Updated)"
+	term.UpdatedAt = util.Ptr(time.Now())
+
+	// 今の紐付けを消してから新しいカテゴリの紐付けで失敗させる
+	defer blockCategoryLink(t, tx, "CATE001PROG000000000000001")()
+	updated, err := term.Update(tx, []CategoryId{"CATE001PROG000000000000001"})
+	assert.Error(t, err, "Expected error, but an error did not occur.")
+	assert.Nil(t, updated, "Updated term should be nil")
+
+	current, err := GetTermWithCategoriesById(tx, "TERM001SQL000000000000001")
+	require.NoError(t, err)
+	assert.Equal(t, original.Term.Name, current.Term.Name, "Term name should not be changed")
+
+	var originalIds, currentIds []CategoryId
+	for _, c := range original.Categories {
+		originalIds = append(originalIds, c.ID)
+	}
+	for _, c := range current.Categories {
+		currentIds = append(currentIds, c.ID)
+	}
+	assert.ElementsMatch(t, originalIds, currentIds, "Category relations should not be changed")
+}
+
+// 一括操作の atomic モードのように、一つのトランザクションで失敗したら前の操作もすべて取り消されることのテスト
+func TestWithTxRollsBackEveryTermOperation(t *testing.T) {
+	const userId = "01HGDJ5GZRJ2J5VEXR8HT8V9WF"
+	const updatedId = TermId("TERM001SQL000000000000001")
+	const deletedId = TermId("TERM002TCP000000000000001")
+
+	testCases := []struct {
+		name    string
+		fail    func(tx SQLExecutor) error
+		wantErr error
+	}{
+		{
+			name: "Create with an empty name",
+			fail: func(tx SQLExecutor) error {
+				_, err := CreateTerm(tx, userId, "", "", nil)
+				return err
+			},
+			wantErr: ErrTermNameRequired,
+		},
+		{
+			name: "Update with a category of another user",
+			fail: func(tx SQLExecutor) error {
+				term, err := GetTermById(tx, "TERM003DOCK00000000000001")
+				require.NoError(t, err)
+				term.UpdatedAt = util.Ptr(time.Now())
+				_, err = term.UpdateWithRevision(tx, []CategoryId{"CATE004ML00000000000000001"}, userId, TermRevisionRetention{})
+				return err
+			},
+			wantErr: ErrCategoryNotOwned,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+
+			revisionsBefore, err := GetTermRevisions(tx, updatedId)
+			require.NoError(t, err)
+
+			var createdId TermId
+			err = WithTx(tx, func(tx SQLExecutor) error {
+				created, err := CreateTerm(tx, userId, "Rollback", "", []CategoryId{"CATE001PROG000000000000001"})
+				require.NoError(t, err)
+				createdId = created.ID
+
+				updated, err := GetTermById(tx, updatedId)
+				require.NoError(t, err)
+				updated.Name = "SQL (Updated)"
+				updated.UpdatedAt = util.Ptr(time.Now())
+				_, err = updated.UpdateWithRevision(tx, []CategoryId{"CATE001PROG000000000000001"}, userId, TermRevisionRetention{})
+				require.NoError(t, err)
+
+				deleted, err := GetTermById(tx, deletedId)
+				require.NoError(t, err)
+				require.NoError(t, deleted.Delete(tx))
+
+				return tc.fail(tx)
+			})
+			assert.ErrorIs(t, err, tc.wantErr)
+
+			_, err = GetTermById(tx, createdId)
+			assert.ErrorIs(t, err, sql.ErrNoRows, "Created term should be rolled back")
+
+			updated, err := GetTermWithCategoriesById(tx, updatedId)
+			require.NoError(t, err)
+			assert.Equal(t, TermName("SQL"), updated.Term.Name, "Update should be rolled back")
+			assert.Equal(t, []CategoryId{"CATE002DBS0000000000000001"}, updated.Content().CategoryIds, "Categories should be rolled back")
+
+			revisionsAfter, err := GetTermRevisions(tx, updatedId)
+			require.NoError(t, err)
+			assert.Len(t, revisionsAfter, len(revisionsBefore), "Revision of the update should be rolled back")
+
+			_, err = GetTermById(tx, deletedId)
+			assert.NoError(t, err, "Delete should be rolled back")
+		})
+	}
+}
+
+// 一括操作の best_effort モードのように、操作ごとのトランザクションなら失敗の前後の操作は残ることのテスト
+func TestWithTxPerTermOperationKeepsOthers(t *testing.T) {
+	const userId = "01HGDJ5GZRJ2J5VEXR8HT8V9WF"
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	var createdId TermId
+	operations := []func(tx SQLExecutor) error{
+		func(tx SQLExecutor) error {
+			created, err := CreateTerm(tx, userId, "Best effort", "", nil)
+			if created != nil {
+				createdId = created.ID
+			}
+			return err
+		},
+		func(tx SQLExecutor) error {
+			// 作成した用語に紐付けてから失敗させる
+			if err := LinkTermWithCategories(tx, createdId, []CategoryId{"CATE001PROG000000000000001"}); err != nil {
+				return err
+			}
+			_, err := CreateTerm(tx, userId, "", "", nil)
+			return err
+		},
+		func(tx SQLExecutor) error {
+			deleted, err := GetTermById(tx, "TERM002TCP000000000000001")
+			if err != nil {
+				return err
+			}
+			return deleted.Delete(tx)
+		},
+	}
+
+	var errs []error
+	for _, operation := range operations {
+		errs = append(errs, WithTx(tx, operation))
+	}
+	assert.NoError(t, errs[0])
+	assert.ErrorIs(t, errs[1], ErrTermNameRequired)
+	assert.NoError(t, errs[2])
+
+	created, err := GetTermWithCategoriesById(tx, createdId)
+	require.NoError(t, err, "Term created before the failure should be kept")
+	assert.Empty(t, created.Categories, "Link of the failed operation should be rolled back")
+
+	_, err = GetTermById(tx, "TERM002TCP000000000000001")
+	assert.ErrorIs(t, err, sql.ErrNoRows, "Term deleted after the failure should be in the trash")
+}
+
+func TestGetCategoriesByTermIds(t *testing.T) {
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	termIds := []TermId{"TERM001SQL000000000000001", "TERM006PYTH00000000000001", "TERM999NONE0000000000001"}
+	categoriesByTermId, err := GetCategoriesByTermIds(tx, termIds)
+	assert.NoError(t, err, "Expected no error, but an error occurred.")
+	assert.Len(t, categoriesByTermId, len(termIds), "Every term should have an entry")
+
+	assert.Len(t, categoriesByTermId["TERM001SQL000000000000001"], 1, "SQL should have one category")
+	assert.Len(t, categoriesByTermId["TERM006PYTH00000000000001"], 2, "Python should have two categories")
+	assert.NotNil(t, categoriesByTermId["TERM999NONE0000000000001"], "Unknown term should have an empty slice")
+	assert.Empty(t, categoriesByTermId["TERM999NONE0000000000001"], "Unknown term should have no categories")
+}
+
+// 用語数に比例してクエリが増えないことを確認するためのベンチマーク
+func BenchmarkGetTermsPageWithCategoriesByUserId(b *testing.B) {
+	const userId = "01HGDJ5GZRJ2J5VEXR8HT8V9WF"
+	const termCount = 2000
+
+	tx, err := DB.Begin()
+	require.NoError(b, err)
+	defer tx.Rollback()
+
+	categoryIds := []CategoryId{"CATE001PROG000000000000001", "CATE002DBS0000000000000001"}
+	for i := 0; i < termCount; i++ {
+		_, err := CreateTerm(tx, userId, TermName(fmt.Sprintf("Bench term %04d", i)), "benchmark", categoryIds)
+		require.NoError(b, err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// GET /terms と同じく一ページずつ取得する
+		page, err := GetTermsPageWithCategoriesByUserId(tx, userId, TermListOptions{Limit: 100})
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(page.Terms) != 100 {
+			b.Fatalf("expected 100 terms, got %d", len(page.Terms))
+		}
+	}
+}
+
+func TestGetTermsPageWithCategoriesByUserId(t *testing.T) {
+	const userId = "01HGDJ5GZRJ2J5VEXR8HT8V9WF"
+
+	testCases := []struct {
+		name     string
+		query    *string
+		category *string
+		sort     *string
+		limit    int
+	}{
+		{
+			name:  "Default order",
+			sort:  nil,
+			limit: 2,
+		},
+		{
+			name:  "Sort by name ascending",
+			sort:  stringPtr("term_asc"),
+			limit: 2,
+		},
+		{
+			name:  "Sort by name descending",
+			sort:  stringPtr("term_desc"),
+			limit: 3,
+		},
+		{
+			name:  "Sort by created_at descending",
+			sort:  stringPtr("created_at_desc"),
+			limit: 1,
+		},
+		{
+			name:  "Sort by updated_at ascending",
+			sort:  stringPtr("updated_at_asc"),
+			limit: 4,
+		},
+		{
+			name:     "With category filter",
+			category: stringPtr("CATE002DBS0000000000000001"),
+			sort:     stringPtr("term_asc"),
+			limit:    1,
+		},
+		{
+			name:  "Everything in one page",
+			sort:  stringPtr("term_asc"),
+			limit: 100,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+
+			base := TermListOptions{Query: tc.query, Sort: tc.sort}
+			if tc.category != nil {
+				base.Categories = []CategoryId{CategoryId(*tc.category)}
+			}
+
+			// ページをたどった結果が一ページで取得したときと同じ順序になること
+			all := base
+			all.Limit = 100
+			allPage, err := GetTermsPageWithCategoriesByUserId(tx, userId, all)
+			require.NoError(t, err)
+			require.Empty(t, allPage.NextCursor, "Every term should fit in one page")
+			expected := allPage.Terms
+
+			var got []TermId
+			var cursor *string
+			for pages := 0; ; pages++ {
+				require.LessOrEqual(t, pages, len(expected), "Too many pages")
+
+				options := base
+				options.Limit = tc.limit
+				options.Cursor = cursor
+				page, err := GetTermsPageWithCategoriesByUserId(tx, userId, options)
+				require.NoError(t, err)
+				assert.Equal(t, len(expected), page.TotalCount, "Total count mismatch")
+				assert.LessOrEqual(t, len(page.Terms), tc.limit, "Page is larger than limit")
+
+				for _, term := range page.Terms {
+					got = append(got, term.Term.ID)
+					assert.NotNil(t, term.Categories, "Categories should not be nil")
+				}
+				if page.NextCursor == "" {
+					break
+				}
+				cursor = util.Ptr(page.NextCursor)
+			}
+
+			var expectedIds []TermId
+			for _, term := range expected {
+				expectedIds = append(expectedIds, term.Term.ID)
+			}
+			assert.Equal(t, expectedIds, got, "Paged terms mismatch")
+		})
+	}
+}
+
+func TestGetTermsPageWithInvalidCursor(t *testing.T) {
+	const userId = "01HGDJ5GZRJ2J5VEXR8HT8V9WF"
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	page, err := GetTermsPageWithCategoriesByUserId(tx, userId, TermListOptions{Sort: stringPtr("term_asc"), Limit: 1})
+	require.NoError(t, err)
+	require.NotEmpty(t, page.NextCursor)
+
+	testCases := []struct {
+		name   string
+		sort   *string
+		cursor string
+	}{
+		{
+			name:   "Not base64",
+			sort:   stringPtr("term_asc"),
+			cursor: "!!!",
+		},
+		{
+			name:   "Not JSON",
+			sort:   stringPtr("term_asc"),
+			cursor: "bm90LWpzb24",
+		},
+		{
+			name:   "Cursor of another sort order",
+			sort:   stringPtr("created_at_asc"),
+			cursor: page.NextCursor,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := GetTermsPageWithCategoriesByUserId(tx, userId, TermListOptions{Sort: tc.sort, Limit: 1, Cursor: &tc.cursor})
+			assert.ErrorIs(t, err, ErrInvalidCursor)
+		})
+	}
+}
+
+func TestGetTermsPageWithFulltextSearch(t *testing.T) {
+	testCases := []struct {
+		name          string
+		userId        TermUserId
+		query         string
+		sort          *string
+		expectedFirst TermId
+		wantEmpty     bool
+	}{
+		{
+			name:          "Match in description",
+			userId:        "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			query:         "暗号化",
+			expectedFirst: "TERM005TLS000000000000001",
+		},
+		{
+			name:          "Most relevant term first",
+			userId:        "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			query:         "Docker",
+			expectedFirst: "TERM003DOCK00000000000001",
+		},
+		{
+			name:      "Terms of other users are not returned",
+			userId:    "01HGDJ5GZRJ2J5VEXR8HT8V9WF",
+			query:     "バージョン管理",
+			wantEmpty: true,
+		},
+		{
+			name:          "Explicit sort instead of relevance",
+			userId:        "01HGDJ5J8KF4L7XGZT0KV1B2YH",
+			query:         "データベース",
+			sort:          stringPtr("term_asc"),
+			expectedFirst: "TERM009NOSQL0000000000001",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+
+			page, err := GetTermsPageWithCategoriesByUserId(tx, tc.userId, TermListOptions{
+				Query:      util.Ptr(tc.query),
+				SearchMode: util.Ptr(SearchModeFulltext),
+				Sort:       tc.sort,
+				Limit:      20,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, len(page.Terms), page.TotalCount, "Total count mismatch")
+
+			if tc.wantEmpty {
+				assert.Empty(t, page.Terms, "Expected no terms")
+				return
+			}
+			require.NotEmpty(t, page.Terms, "Expected matching terms")
+			assert.Equal(t, tc.expectedFirst, page.Terms[0].Term.ID, "Unexpected first term")
+		})
+	}
+}
+
+// 関連度順でもページをたどって全件取得できることのテスト
+func TestGetTermsPageWithFulltextSearchPaging(t *testing.T) {
+	const userId = "01HGDJ5GZRJ2J5VEXR8HT8V9WF"
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	options := TermListOptions{
+		Query:      util.Ptr("プロトコル"),
+		SearchMode: util.Ptr(SearchModeFulltext),
+		Limit:      1,
+	}
+
+	seen := map[TermId]bool{}
+	total := -1
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 10, "Too many pages")
+
+		page, err := GetTermsPageWithCategoriesByUserId(tx, userId, options)
+		require.NoError(t, err)
+		total = page.TotalCount
+		for _, term := range page.Terms {
+			assert.False(t, seen[term.Term.ID], "Term returned twice: %s", term.Term.ID)
+			seen[term.Term.ID] = true
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		options.Cursor = util.Ptr(page.NextCursor)
+	}
+
+	assert.Greater(t, total, 1, "Expected several matching terms")
+	assert.Len(t, seen, total, "Every matching term should be returned once")
+}
+
+func TestGetTermsPageWithCategoryFilter(t *testing.T) {
+	const userId = "01HGDJ5GZRJ2J5VEXR8HT8V9WF"
+	const (
+		prog = CategoryId("CATE001PROG000000000000001")
+		dbs  = CategoryId("CATE002DBS0000000000000001")
+		net  = CategoryId("CATE003NET0000000000000001")
+	)
+
+	testCases := []struct {
+		name          string
+		categories    []CategoryId
+		categoryMatch *string
+		uncategorized bool
+		expectedNames []TermName
+	}{
+		{
+			name:          "All of two categories",
+			categories:    []CategoryId{prog, dbs},
+			categoryMatch: util.Ptr(CategoryMatchAll),
+			expectedNames: []TermName{"Overlap Go+SQL"},
+		},
+		{
+			name:          "All is the default",
+			categories:    []CategoryId{dbs, net},
+			expectedNames: []TermName{"Overlap SQL+Net"},
+		},
+		{
+			name:          "Any of two categories",
+			categories:    []CategoryId{prog, dbs},
+			categoryMatch: util.Ptr(CategoryMatchAny),
+			expectedNames: []TermName{"Overlap Go", "Overlap Go+SQL", "Overlap SQL+Net"},
+		},
+		{
+			name:          "Duplicated categories count once",
+			categories:    []CategoryId{prog, prog},
+			categoryMatch: util.Ptr(CategoryMatchAll),
+			expectedNames: []TermName{"Overlap Go", "Overlap Go+SQL"},
+		},
+		{
+			name:          "Uncategorized only",
+			uncategorized: true,
+			expectedNames: []TermName{"Overlap None"},
+		},
+		{
+			name:          "Any category or uncategorized",
+			categories:    []CategoryId{net},
+			categoryMatch: util.Ptr(CategoryMatchAny),
+			uncategorized: true,
+			expectedNames: []TermName{"Overlap None", "Overlap SQL+Net"},
+		},
+		{
+			name:          "All categories and uncategorized never match",
+			categories:    []CategoryId{net},
+			categoryMatch: util.Ptr(CategoryMatchAll),
+			uncategorized: true,
+			expectedNames: []TermName{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+
+			// カテゴリが重なり合う用語を用意する
+			terms := map[TermName][]CategoryId{
+				"Overlap Go":      {prog},
+				"Overlap Go+SQL":  {prog, dbs},
+				"Overlap SQL+Net": {dbs, net},
+				"Overlap None":    {},
+			}
+			for name, categoryIds := range terms {
+				_, err := CreateTerm(tx, userId, name, "", categoryIds)
+				require.NoError(t, err)
+			}
+
+			page, err := GetTermsPageWithCategoriesByUserId(tx, userId, TermListOptions{
+				Query:         util.Ptr("Overlap"),
+				Categories:    tc.categories,
+				CategoryMatch: tc.categoryMatch,
+				Uncategorized: tc.uncategorized,
+				Sort:          util.Ptr("term_asc"),
+				Limit:         20,
+			})
+			require.NoError(t, err)
+
+			names := []TermName{}
+			for _, term := range page.Terms {
+				names = append(names, term.Term.Name)
+			}
+			assert.Equal(t, tc.expectedNames, names, "Filtered terms mismatch")
+			assert.Equal(t, len(tc.expectedNames), page.TotalCount, "Total count mismatch")
+		})
+	}
+}
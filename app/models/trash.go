@@ -0,0 +1,231 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/takuchi17/term-keeper/app/models/queries"
+)
+
+var ErrNotInTrash = errors.New("not in the trash")
+
+// TrashedTerm is a deleted term that can still be restored.
+type TrashedTerm struct {
+	Term      *Term
+	DeletedAt time.Time
+}
+
+// TrashedCategory is a deleted category that can still be restored.
+type TrashedCategory struct {
+	Category  *Category
+	DeletedAt time.Time
+}
+
+// Trash is every deleted term and category of a user, the latest first.
+type Trash struct {
+	Terms      []*TrashedTerm
+	Categories []*TrashedCategory
+}
+
+func GetTrashByUserId(db SQLExecutor, userId UserId) (*Trash, error) {
+	trash := &Trash{Terms: []*TrashedTerm{}, Categories: []*TrashedCategory{}}
+
+	termRows, err := db.Query(queries.GetTrashedTermsByUserId, userId)
+	if err != nil {
+		slog.Error("Failed to get trashed terms", "err", err)
+		return nil, err
+	}
+	defer termRows.Close()
+	for termRows.Next() {
+		term, err := scanTrashedTerm(termRows)
+		if err != nil {
+			return nil, err
+		}
+		trash.Terms = append(trash.Terms, term)
+	}
+	if err := termRows.Err(); err != nil {
+		slog.Error("Failed to iterate trashed terms", "err", err)
+		return nil, err
+	}
+
+	categoryRows, err := db.Query(queries.GetTrashedCategoriesByUserId, userId)
+	if err != nil {
+		slog.Error("Failed to get trashed categories", "err", err)
+		return nil, err
+	}
+	defer categoryRows.Close()
+	for categoryRows.Next() {
+		category, err := scanTrashedCategory(categoryRows)
+		if err != nil {
+			return nil, err
+		}
+		trash.Categories = append(trash.Categories, category)
+	}
+	if err := categoryRows.Err(); err != nil {
+		slog.Error("Failed to iterate trashed categories", "err", err)
+		return nil, err
+	}
+
+	return trash, nil
+}
+
+// GetTrashedTermById returns ErrNotInTrash unless the term is in the trash.
+func GetTrashedTermById(db SQLExecutor, id TermId) (*TrashedTerm, error) {
+	term, err := scanTrashedTerm(db.QueryRow(queries.GetTrashedTermById, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotInTrash
+	}
+	if err != nil {
+		return nil, err
+	}
+	return term, nil
+}
+
+// GetTrashedCategoryById returns ErrNotInTrash unless the category is in the trash.
+func GetTrashedCategoryById(db SQLExecutor, id CategoryId) (*TrashedCategory, error) {
+	category, err := scanTrashedCategory(db.QueryRow(queries.GetTrashedCategoryById, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotInTrash
+	}
+	if err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// Restore takes the term out of the trash with its categories and sources.
+func (t *TrashedTerm) Restore(db SQLExecutor) error {
+	result, err := db.Exec(queries.RestoreTerm, t.Term.ID)
+	if err != nil {
+		slog.Error("Failed to restore term", "err", err)
+		return err
+	}
+	return requireRowAffected(result)
+}
+
+// Restore takes the category out of the trash. It returns
+// ErrDuplicateCategoryName when another category got the same name meanwhile.
+func (c *TrashedCategory) Restore(db SQLExecutor) error {
+	isDuplicate, err := IsDuplicateCategoryName(db, c.Category.FKUserId, c.Category.Name, c.Category.ID)
+	if err != nil {
+		return err
+	}
+	if isDuplicate {
+		return ErrDuplicateCategoryName
+	}
+
+	result, err := db.Exec(queries.RestoreCategory, c.Category.ID)
+	if err != nil {
+		if isDuplicateEntryError(err) {
+			return ErrDuplicateCategoryName
+		}
+		slog.Error("Failed to restore category", "err", err)
+		return err
+	}
+	return requireRowAffected(result)
+}
+
+// Purge deletes the term permanently together with everything linked to it.
+func (t *TrashedTerm) Purge(db SQLExecutor) error {
+	result, err := db.Exec(queries.PurgeTerm, t.Term.ID)
+	if err != nil {
+		slog.Error("Failed to purge term", "err", err)
+		return err
+	}
+	return requireRowAffected(result)
+}
+
+// Purge deletes the category permanently and unlinks it from the terms.
+func (c *TrashedCategory) Purge(db SQLExecutor) error {
+	result, err := db.Exec(queries.PurgeCategory, c.Category.ID)
+	if err != nil {
+		slog.Error("Failed to purge category", "err", err)
+		return err
+	}
+	return requireRowAffected(result)
+}
+
+// PurgeTrash permanently deletes the terms and categories of every user that
+// were moved to the trash before the given time, batchSize rows at a time.
+// It returns the number of deleted terms and categories.
+func PurgeTrash(db SQLExecutor, before time.Time, batchSize int) (int, int, error) {
+	terms, err := purgeInBatches(db, queries.PurgeTermsDeletedBefore, before, batchSize)
+	if err != nil {
+		slog.Error("Failed to purge trashed terms", "err", err)
+		return terms, 0, err
+	}
+	categories, err := purgeInBatches(db, queries.PurgeCategoriesDeletedBefore, before, batchSize)
+	if err != nil {
+		slog.Error("Failed to purge trashed categories", "err", err)
+		return terms, categories, err
+	}
+	return terms, categories, nil
+}
+
+func purgeInBatches(db SQLExecutor, query string, before time.Time, batchSize int) (int, error) {
+	total := 0
+	for {
+		result, err := db.Exec(query, before, batchSize)
+		if err != nil {
+			return total, err
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return total, err
+		}
+		total += int(affected)
+		if affected < int64(batchSize) {
+			return total, nil
+		}
+	}
+}
+
+// 取得してから更新するまでに、ほかのリクエストで戻されたり削除されたりしたとき
+func requireRowAffected(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNotInTrash
+	}
+	return nil
+}
+
+func scanTrashedTerm(row interface{ Scan(...any) error }) (*TrashedTerm, error) {
+	var term Term
+	var trashed TrashedTerm
+	err := row.Scan(
+		&term.ID, &term.FKUserId, &term.Name, &term.Description, &term.Status, &term.StatusChangedAt,
+		&term.CreatedAt, &term.UpdatedAt, &trashed.DeletedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if err != nil {
+		slog.Error("Failed to scan trashed term", "err", err)
+		return nil, err
+	}
+	trashed.Term = &term
+	return &trashed, nil
+}
+
+func scanTrashedCategory(row interface{ Scan(...any) error }) (*TrashedCategory, error) {
+	var category Category
+	var trashed TrashedCategory
+	err := row.Scan(
+		&category.ID, &category.Name, &category.FKUserId, &category.HexColorCode,
+		&category.CreatedAt, &category.UpdatedAt, &trashed.DeletedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if err != nil {
+		slog.Error("Failed to scan trashed category", "err", err)
+		return nil, err
+	}
+	trashed.Category = &category
+	return &trashed, nil
+}
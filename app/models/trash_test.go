@@ -0,0 +1,144 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrashRestoreTerm(t *testing.T) {
+	const (
+		userId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+		termId = TermId("TERM001SQL000000000000001")
+	)
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	term, err := GetTermById(tx, termId)
+	require.NoError(t, err)
+	require.NoError(t, term.Delete(tx))
+
+	trash, err := GetTrashByUserId(tx, userId)
+	require.NoError(t, err)
+	require.Len(t, trash.Terms, 1)
+	assert.Equal(t, termId, trash.Terms[0].Term.ID)
+	assert.Empty(t, trash.Categories)
+
+	trashed, err := GetTrashedTermById(tx, termId)
+	require.NoError(t, err)
+	require.NoError(t, trashed.Restore(tx))
+
+	// カテゴリとの紐付けも戻る
+	restored, err := GetTermWithCategoriesById(tx, termId)
+	require.NoError(t, err)
+	assert.Equal(t, []CategoryId{"CATE002DBS0000000000000001"}, restored.Content().CategoryIds)
+
+	_, err = GetTrashedTermById(tx, termId)
+	assert.ErrorIs(t, err, ErrNotInTrash, "Restored term should not be in the trash")
+	assert.ErrorIs(t, trashed.Restore(tx), ErrNotInTrash, "Restoring twice should fail")
+}
+
+func TestTrashRestoreCategory(t *testing.T) {
+	const userId = CategoryUserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+
+	testCases := []struct {
+		name        string
+		reuseName   bool
+		expectedErr error
+	}{
+		{
+			name: "Restore category",
+		},
+		{
+			name:        "Name was reused meanwhile",
+			reuseName:   true,
+			expectedErr: ErrDuplicateCategoryName,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+
+			category, err := GetCategoryById(tx, "CATE001PROG000000000000001")
+			require.NoError(t, err)
+			require.NoError(t, category.Delete(tx))
+
+			// ゴミ箱のカテゴリと同じ名前で作れる
+			if tc.reuseName {
+				_, err := CreateCategory(tx, userId, category.Name, "")
+				require.NoError(t, err)
+			}
+
+			trashed, err := GetTrashedCategoryById(tx, category.ID)
+			require.NoError(t, err)
+			err = trashed.Restore(tx)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			categoryIds, err := GetCategoryIdsByTermId(tx, "TERM003DOCK00000000000001")
+			require.NoError(t, err)
+			assert.Equal(t, []CategoryId{category.ID}, categoryIds, "Term should be linked again")
+		})
+	}
+}
+
+func TestTrashPurgeTerm(t *testing.T) {
+	const termId = TermId("TERM002TCP000000000000001")
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	term, err := GetTermById(tx, termId)
+	require.NoError(t, err)
+	require.NoError(t, term.Delete(tx))
+
+	trashed, err := GetTrashedTermById(tx, termId)
+	require.NoError(t, err)
+	require.NoError(t, trashed.Purge(tx))
+
+	var count int
+	require.NoError(t, tx.QueryRow(`SELECT COUNT(*) FROM terms WHERE id = ?`, termId).Scan(&count))
+	assert.Equal(t, 0, count, "Term should be deleted")
+	require.NoError(t, tx.QueryRow(`SELECT COUNT(*) FROM term_category_relations WHERE fk_term_id = ?`, termId).Scan(&count))
+	assert.Equal(t, 0, count, "Term category relations should be deleted")
+}
+
+func TestPurgeTrash(t *testing.T) {
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	now := time.Now()
+	old := now.Add(-40 * 24 * time.Hour)
+	// 期限を過ぎたものと、まだ残すもの
+	_, err = tx.Exec(`UPDATE terms SET deleted_at = ? WHERE id IN (?, ?, ?)`, old,
+		"TERM001SQL000000000000001", "TERM002TCP000000000000001", "TERM006PYTH00000000000001")
+	require.NoError(t, err)
+	_, err = tx.Exec(`UPDATE terms SET deleted_at = ? WHERE id = ?`, now, "TERM003DOCK00000000000001")
+	require.NoError(t, err)
+	_, err = tx.Exec(`UPDATE categories SET deleted_at = ? WHERE id = ?`, old, "CATE003NET0000000000000001")
+	require.NoError(t, err)
+
+	// 一度に 2 件ずつ削除しても全件消える
+	terms, categories, err := PurgeTrash(tx, now.Add(-30*24*time.Hour), 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, terms)
+	assert.Equal(t, 1, categories)
+
+	var count int
+	require.NoError(t, tx.QueryRow(`SELECT COUNT(*) FROM terms WHERE deleted_at IS NOT NULL`).Scan(&count))
+	assert.Equal(t, 1, count, "Recently trashed term should be kept")
+	require.NoError(t, tx.QueryRow(`SELECT COUNT(*) FROM categories WHERE deleted_at IS NOT NULL`).Scan(&count))
+	assert.Equal(t, 0, count, "Old trashed category should be deleted")
+}
@@ -0,0 +1,316 @@
+package models
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/base32"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/takuchi17/term-keeper/app/models/queries"
+	"github.com/takuchi17/term-keeper/pkg/totp"
+)
+
+// TwoFactorStatus is whether TOTP is enabled for a user.
+type TwoFactorStatus struct {
+	Enabled                bool
+	ConfirmedAt            *time.Time
+	RecoveryCodesRemaining int
+}
+
+var (
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotEnrolled    = errors.New("two-factor authentication enrollment has not been started")
+	ErrTwoFactorNotEnabled     = errors.New("two-factor authentication is not enabled")
+	ErrInvalidTwoFactorCode    = errors.New("invalid two-factor code")
+)
+
+const (
+	recoveryCodeCount = 10
+	// 5 文字ずつハイフンで区切って表示する
+	recoveryCodeLength = 10
+)
+
+// BeginTOTPEnrollment creates a new TOTP secret for the user. It is not used
+// for login until ConfirmTOTPEnrollment is called with a code of it. Starting
+// again replaces the secret of an unconfirmed enrollment.
+func BeginTOTPEnrollment(db SQLExecutor, userId UserId) (string, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return "", err
+	}
+
+	err = WithTx(db, func(tx SQLExecutor) error {
+		_, confirmedAt, _, err := getTOTPForUpdate(tx, userId)
+		if err != nil && !errors.Is(err, ErrTwoFactorNotEnrolled) {
+			return err
+		}
+		if confirmedAt != nil {
+			return ErrTwoFactorAlreadyEnabled
+		}
+
+		if _, err := tx.Exec(queries.UpsertPendingTOTP, userId, secret, time.Now()); err != nil {
+			slog.Error("Failed to create totp secret", "err", err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+// ConfirmTOTPEnrollment enables TOTP when the code matches the pending secret
+// and returns new recovery codes, which are shown only once.
+func ConfirmTOTPEnrollment(db SQLExecutor, userId UserId, code string) ([]string, error) {
+	var recoveryCodes []string
+	err := WithTx(db, func(tx SQLExecutor) error {
+		secret, confirmedAt, lastStep, err := getTOTPForUpdate(tx, userId)
+		if err != nil {
+			return err
+		}
+		if confirmedAt != nil {
+			return ErrTwoFactorAlreadyEnabled
+		}
+
+		now := time.Now()
+		step, ok := totp.Verify(secret, strings.TrimSpace(code), now, lastStep)
+		if !ok {
+			return ErrInvalidTwoFactorCode
+		}
+		if _, err := tx.Exec(queries.ConfirmTOTP, now, step, userId); err != nil {
+			slog.Error("Failed to confirm totp", "err", err)
+			return err
+		}
+
+		recoveryCodes, err = replaceRecoveryCodes(tx, userId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return recoveryCodes, nil
+}
+
+// GetTwoFactorStatus returns whether TOTP is enabled for the user and how many
+// recovery codes are left.
+func GetTwoFactorStatus(db SQLExecutor, userId UserId) (*TwoFactorStatus, error) {
+	var confirmedAt *time.Time
+	err := db.QueryRow(queries.GetTOTPConfirmedAtByUserId, userId).Scan(&confirmedAt)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && confirmedAt == nil) {
+		return &TwoFactorStatus{}, nil
+	}
+	if err != nil {
+		slog.Error("Failed to get totp", "err", err)
+		return nil, err
+	}
+
+	status := &TwoFactorStatus{Enabled: true, ConfirmedAt: confirmedAt}
+	if err := db.QueryRow(queries.CountUnusedRecoveryCodesByUserId, userId).Scan(&status.RecoveryCodesRemaining); err != nil {
+		slog.Error("Failed to count recovery codes", "err", err)
+		return nil, err
+	}
+	return status, nil
+}
+
+// VerifyTwoFactorCode uses up a TOTP or recovery code of the user. It returns
+// ErrTwoFactorNotEnabled when the user has not enabled TOTP.
+func VerifyTwoFactorCode(db SQLExecutor, userId UserId, code string) error {
+	return WithTx(db, func(tx SQLExecutor) error {
+		return verifyTwoFactorCode(tx, userId, code)
+	})
+}
+
+// DisableTwoFactor removes the TOTP secret and the recovery codes of the user.
+// The caller checks a code with VerifyTwoFactorCode first.
+func DisableTwoFactor(db SQLExecutor, userId UserId) error {
+	return WithTx(db, func(tx SQLExecutor) error {
+		if _, err := getEnabledTOTPForUpdate(tx, userId); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(queries.DeleteTOTPByUserId, userId); err != nil {
+			slog.Error("Failed to delete totp", "err", err)
+			return err
+		}
+		if _, err := tx.Exec(queries.DeleteRecoveryCodesByUserId, userId); err != nil {
+			slog.Error("Failed to delete recovery codes", "err", err)
+			return err
+		}
+		return nil
+	})
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the user. The caller
+// checks a code with VerifyTwoFactorCode first.
+func RegenerateRecoveryCodes(db SQLExecutor, userId UserId) ([]string, error) {
+	var recoveryCodes []string
+	err := WithTx(db, func(tx SQLExecutor) error {
+		if _, err := getEnabledTOTPForUpdate(tx, userId); err != nil {
+			return err
+		}
+		var err error
+		recoveryCodes, err = replaceRecoveryCodes(tx, userId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return recoveryCodes, nil
+}
+
+// IssueLoginChallenge returns a short-lived token that stands for a correct
+// password until CompleteLoginChallenge is called with a code.
+func IssueLoginChallenge(db SQLExecutor, userId UserId) (string, error) {
+	return IssueUserToken(db, userId, UserTokenPurposeLoginChallenge)
+}
+
+// GetLoginChallengeUserId returns the user of a valid challenge without
+// using it up.
+func GetLoginChallengeUserId(db SQLExecutor, challenge string) (UserId, error) {
+	_, userId, err := getValidUserToken(db, queries.GetUserTokenByHash, challenge, UserTokenPurposeLoginChallenge)
+	return userId, err
+}
+
+// CompleteLoginChallenge uses up the challenge when the code is a valid TOTP
+// or recovery code of its user. A wrong code leaves the challenge valid so a
+// typo does not require the password again; attempts are limited by the
+// caller.
+func CompleteLoginChallenge(db SQLExecutor, challenge string, code string) (UserId, error) {
+	var userId UserId
+	err := WithTx(db, func(tx SQLExecutor) error {
+		var err error
+		userId, err = consumeUserToken(tx, challenge, UserTokenPurposeLoginChallenge)
+		if err != nil {
+			return err
+		}
+		return verifyTwoFactorCode(tx, userId, code)
+	})
+	if err != nil {
+		return "", err
+	}
+	return userId, nil
+}
+
+// 6 桁の数字なら TOTP、それ以外はリカバリーコードとして確かめる
+func verifyTwoFactorCode(tx SQLExecutor, userId UserId, code string) error {
+	enabled, err := getEnabledTOTPForUpdate(tx, userId)
+	if err != nil {
+		return err
+	}
+
+	code = strings.TrimSpace(code)
+	if isTOTPCode(code) {
+		step, ok := totp.Verify(enabled.secret, code, time.Now(), enabled.lastStep)
+		if !ok {
+			return ErrInvalidTwoFactorCode
+		}
+		if _, err := tx.Exec(queries.UpdateTOTPLastUsedStep, step, userId); err != nil {
+			slog.Error("Failed to update totp step", "err", err)
+			return err
+		}
+		return nil
+	}
+
+	var id string
+	err = tx.QueryRow(queries.GetUnusedRecoveryCodeForUpdate, userId, hashToken(normalizeRecoveryCode(code))).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrInvalidTwoFactorCode
+	}
+	if err != nil {
+		slog.Error("Failed to get recovery code", "err", err)
+		return err
+	}
+	if _, err := tx.Exec(queries.MarkRecoveryCodeUsed, time.Now(), id); err != nil {
+		slog.Error("Failed to mark recovery code used", "err", err)
+		return err
+	}
+	return nil
+}
+
+func getTOTPForUpdate(tx SQLExecutor, userId UserId) (string, *time.Time, int64, error) {
+	var (
+		secret      string
+		confirmedAt *time.Time
+		lastStep    int64
+	)
+	err := tx.QueryRow(queries.GetTOTPByUserIdForUpdate, userId).Scan(&secret, &confirmedAt, &lastStep)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil, 0, ErrTwoFactorNotEnrolled
+	}
+	if err != nil {
+		slog.Error("Failed to get totp", "err", err)
+		return "", nil, 0, err
+	}
+	return secret, confirmedAt, lastStep, nil
+}
+
+type enabledTOTP struct {
+	secret   string
+	lastStep int64
+}
+
+// 登録が確認済みでなければ ErrTwoFactorNotEnabled
+func getEnabledTOTPForUpdate(tx SQLExecutor, userId UserId) (*enabledTOTP, error) {
+	secret, confirmedAt, lastStep, err := getTOTPForUpdate(tx, userId)
+	if errors.Is(err, ErrTwoFactorNotEnrolled) || (err == nil && confirmedAt == nil) {
+		return nil, ErrTwoFactorNotEnabled
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &enabledTOTP{secret: secret, lastStep: lastStep}, nil
+}
+
+func replaceRecoveryCodes(tx SQLExecutor, userId UserId) ([]string, error) {
+	if _, err := tx.Exec(queries.DeleteRecoveryCodesByUserId, userId); err != nil {
+		slog.Error("Failed to delete recovery codes", "err", err)
+		return nil, err
+	}
+
+	now := time.Now()
+	codes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := tx.Exec(queries.CreateRecoveryCode, newULID(), userId, hashToken(code), now); err != nil {
+			slog.Error("Failed to create recovery code", "err", err)
+			return nil, err
+		}
+		codes[i] = code[:recoveryCodeLength/2] + "-" + code[recoveryCodeLength/2:]
+	}
+	return codes, nil
+}
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func generateRecoveryCode() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return strings.ToLower(recoveryCodeEncoding.EncodeToString(b))[:recoveryCodeLength], nil
+}
+
+// 表示用のハイフンや空白を除き、大文字で入力されても受け付ける
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != totp.Digits {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,142 @@
+package models
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takuchi17/term-keeper/pkg/totp"
+)
+
+// enableTOTP は TOTP を有効にし、秘密鍵とリカバリーコードを返す
+func enableTOTP(t *testing.T, db SQLExecutor, userId UserId) (string, []string) {
+	t.Helper()
+	secret, err := BeginTOTPEnrollment(db, userId)
+	require.NoError(t, err)
+	code, err := totp.Code(secret, time.Now())
+	require.NoError(t, err)
+	recoveryCodes, err := ConfirmTOTPEnrollment(db, userId, code)
+	require.NoError(t, err)
+	return secret, recoveryCodes
+}
+
+func TestTOTPEnrollment(t *testing.T) {
+	const userId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	_, err = ConfirmTOTPEnrollment(tx, userId, "123456")
+	assert.ErrorIs(t, err, ErrTwoFactorNotEnrolled)
+
+	oldSecret, err := BeginTOTPEnrollment(tx, userId)
+	require.NoError(t, err)
+	secret, err := BeginTOTPEnrollment(tx, userId)
+	require.NoError(t, err)
+	assert.NotEqual(t, oldSecret, secret, "Secret should be replaced until confirmed")
+
+	status, err := GetTwoFactorStatus(tx, userId)
+	require.NoError(t, err)
+	assert.False(t, status.Enabled, "Unconfirmed enrollment should not be enabled")
+
+	_, err = ConfirmTOTPEnrollment(tx, userId, "000000")
+	assert.ErrorIs(t, err, ErrInvalidTwoFactorCode)
+
+	code, err := totp.Code(secret, time.Now())
+	require.NoError(t, err)
+	recoveryCodes, err := ConfirmTOTPEnrollment(tx, userId, code)
+	require.NoError(t, err)
+	assert.Len(t, recoveryCodes, recoveryCodeCount)
+
+	status, err = GetTwoFactorStatus(tx, userId)
+	require.NoError(t, err)
+	assert.True(t, status.Enabled)
+	assert.Equal(t, recoveryCodeCount, status.RecoveryCodesRemaining)
+
+	_, err = BeginTOTPEnrollment(tx, userId)
+	assert.ErrorIs(t, err, ErrTwoFactorAlreadyEnabled)
+}
+
+func TestCompleteLoginChallenge(t *testing.T) {
+	const userId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	secret, recoveryCodes := enableTOTP(t, tx, userId)
+
+	challenge, err := IssueLoginChallenge(tx, userId)
+	require.NoError(t, err)
+	challengeUserId, err := GetLoginChallengeUserId(tx, challenge)
+	require.NoError(t, err)
+	assert.Equal(t, userId, challengeUserId)
+
+	_, err = CompleteLoginChallenge(tx, challenge, "000000")
+	assert.ErrorIs(t, err, ErrInvalidTwoFactorCode)
+
+	// 登録で使ったコードはもう使えない
+	confirmedCode, err := totp.Code(secret, time.Now())
+	require.NoError(t, err)
+	_, err = CompleteLoginChallenge(tx, challenge, confirmedCode)
+	assert.ErrorIs(t, err, ErrInvalidTwoFactorCode, "Code should not be replayed")
+
+	nextCode, err := totp.Code(secret, time.Now().Add(totp.Period))
+	require.NoError(t, err)
+	loggedInUserId, err := CompleteLoginChallenge(tx, challenge, nextCode)
+	require.NoError(t, err, "Challenge should stay valid after a wrong code")
+	assert.Equal(t, userId, loggedInUserId)
+
+	_, err = CompleteLoginChallenge(tx, challenge, nextCode)
+	assert.ErrorIs(t, err, ErrInvalidUserToken, "Challenge should be single-use")
+
+	// リカバリーコードは大文字やハイフンなしでも一度だけ使える
+	challenge, err = IssueLoginChallenge(tx, userId)
+	require.NoError(t, err)
+	recoveryCode := strings.ToUpper(strings.ReplaceAll(recoveryCodes[0], "-", ""))
+	_, err = CompleteLoginChallenge(tx, challenge, recoveryCode)
+	require.NoError(t, err)
+
+	challenge, err = IssueLoginChallenge(tx, userId)
+	require.NoError(t, err)
+	_, err = CompleteLoginChallenge(tx, challenge, recoveryCodes[0])
+	assert.ErrorIs(t, err, ErrInvalidTwoFactorCode, "Recovery code should be single-use")
+
+	status, err := GetTwoFactorStatus(tx, userId)
+	require.NoError(t, err)
+	assert.Equal(t, recoveryCodeCount-1, status.RecoveryCodesRemaining)
+}
+
+func TestDisableTwoFactor(t *testing.T) {
+	const userId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	err = VerifyTwoFactorCode(tx, userId, "123456")
+	assert.ErrorIs(t, err, ErrTwoFactorNotEnabled)
+	err = DisableTwoFactor(tx, userId)
+	assert.ErrorIs(t, err, ErrTwoFactorNotEnabled)
+
+	_, recoveryCodes := enableTOTP(t, tx, userId)
+
+	newCodes, err := RegenerateRecoveryCodes(tx, userId)
+	require.NoError(t, err)
+	assert.Len(t, newCodes, recoveryCodeCount)
+
+	err = VerifyTwoFactorCode(tx, userId, recoveryCodes[0])
+	assert.ErrorIs(t, err, ErrInvalidTwoFactorCode, "Old recovery codes should be replaced")
+	err = VerifyTwoFactorCode(tx, userId, newCodes[0])
+	require.NoError(t, err)
+
+	err = DisableTwoFactor(tx, userId)
+	require.NoError(t, err)
+
+	status, err := GetTwoFactorStatus(tx, userId)
+	require.NoError(t, err)
+	assert.False(t, status.Enabled)
+}
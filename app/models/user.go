@@ -4,10 +4,15 @@ import (
 	"errors"
 	"log/slog"
 	"math/rand"
+	"net/mail"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/oklog/ulid/v2"
 	"github.com/takuchi17/term-keeper/app/models/queries"
+	"github.com/takuchi17/term-keeper/pkg/passwordpolicy"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -17,44 +22,77 @@ type Email string
 type Password string
 type HashedPassword string
 
+var (
+	ErrPasswordRequired = errors.New("password is required")
+	ErrPasswordTooShort = passwordpolicy.ErrTooShort
+	ErrPasswordTooLong  = passwordpolicy.ErrTooLong
+	ErrPasswordCommon   = passwordpolicy.ErrCommon
+	ErrUserNameRequired = errors.New("username is required")
+	ErrUserNameTooLong  = errors.New("username is too long")
+	ErrUserNameInvalid  = errors.New("username contains characters that are not allowed")
+	ErrEmailRequired    = errors.New("email is required")
+	ErrEmailInvalid     = errors.New("email is invalid")
+	ErrEmailAlreadyUsed = errors.New("email is already used")
+	ErrEmailUnchanged   = errors.New("email is the same as the current one")
+)
+
+const (
+	// users.name と users.email の長さ
+	maxUserNameLength = 32
+	maxEmailLength    = 255
+)
+
 type User struct {
-	ID        UserId
-	Name      UserName
-	Email     Email
-	Password  HashedPassword
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID       UserId
+	Name     UserName
+	Email    Email
+	Password HashedPassword
+	// メールアドレスを確認していなければ nil
+	EmailVerifiedAt *time.Time
+	// 変更を申し込み、まだ確認されていないメールアドレス
+	PendingEmail *Email
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
 }
 
-func CreateUser(db SQLExecutor, name UserName, email Email, password Password) error {
-	// cheack required fields
-	if name == "" {
-		return errors.New("username is required")
+// CreateUser registers a user. When some fields are invalid, the returned
+// error joins the errors of every field so that they can be shown at once.
+func CreateUser(db SQLExecutor, name UserName, email Email, password Password) (*User, error) {
+	name = UserName(strings.TrimSpace(string(name)))
+	email = Email(strings.TrimSpace(string(email)))
+	if err := errors.Join(validateUserName(name), validateEmail(email), ValidatePassword(password)); err != nil {
+		return nil, err
 	}
-	if email == "" {
-		return errors.New("umail is required")
+
+	duplicate, err := IsDuplicateEmail(db, email)
+	if err != nil {
+		return nil, err
 	}
-	if password == "" {
-		return errors.New("password is required")
+	if duplicate {
+		return nil, ErrEmailAlreadyUsed
 	}
+
 	// generate ulid for userId
 	t := time.Now()
 	entropy := ulid.Monotonic(rand.New(rand.NewSource(t.UnixNano())), 0)
-	userId := ulid.MustNew(ulid.Timestamp(t), entropy).String()
+	userId := UserId(ulid.MustNew(ulid.Timestamp(t), entropy).String())
 
 	// generate hash from plain password
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hashedPassword, err := hashPassword(password)
 	if err != nil {
-		slog.Error("Failed to generate hash", "err", err)
-		return err
+		return nil, err
 	}
 
 	_, err = db.Exec(queries.CreateUser, userId, name, email, hashedPassword, t, t)
 	if err != nil {
+		// 確認したあとに同じメールアドレスで登録された
+		if isDuplicateEntryError(err) {
+			return nil, ErrEmailAlreadyUsed
+		}
 		slog.Error("Failed to register user", "err", err)
-		return err
+		return nil, err
 	}
-	return nil
+	return &User{ID: userId, Name: name, Email: email, Password: hashedPassword, CreatedAt: t, UpdatedAt: t}, nil
 }
 
 func IsDuplicateEmail(db SQLExecutor, email Email) (bool, error) {
@@ -73,35 +111,236 @@ func IsSamePassword(db SQLExecutor, hashedPassword HashedPassword, plainPassword
 
 func GetUserById(db SQLExecutor, id UserId) (*User, error) {
 	var (
-		name      UserName
-		email     Email
-		createdAt time.Time
-		updatedAt time.Time
+		name            UserName
+		email           Email
+		emailVerifiedAt *time.Time
+		pendingEmail    *Email
+		createdAt       time.Time
+		updatedAt       time.Time
 	)
-	err := db.QueryRow(queries.GetUserById, id).Scan(&name, &email, &createdAt, &updatedAt)
+	err := db.QueryRow(queries.GetUserById, id).Scan(&name, &email, &emailVerifiedAt, &pendingEmail, &createdAt, &updatedAt)
 
 	if err != nil {
 		slog.Error("Failed to get user by id", "err", err)
 		return nil, err
 	}
 
-	return &User{ID: id, Name: name, Email: email, CreatedAt: createdAt, UpdatedAt: updatedAt}, nil
+	return &User{ID: id, Name: name, Email: email, EmailVerifiedAt: emailVerifiedAt, PendingEmail: pendingEmail, CreatedAt: createdAt, UpdatedAt: updatedAt}, nil
 }
 
 func GetUserByEmail(db SQLExecutor, email Email) (*User, error) {
 	var (
-		name      UserName
-		id        UserId
-		password  HashedPassword
-		createdAt time.Time
-		updatedAt time.Time
+		name            UserName
+		id              UserId
+		password        HashedPassword
+		emailVerifiedAt *time.Time
+		createdAt       time.Time
+		updatedAt       time.Time
 	)
-	err := db.QueryRow(queries.GetUserByEmail, email).Scan(&name, &id, &password, &createdAt, &updatedAt)
+	err := db.QueryRow(queries.GetUserByEmail, email).Scan(&name, &id, &password, &emailVerifiedAt, &createdAt, &updatedAt)
 
 	if err != nil {
 		slog.Error("Failed to get user by email", "err", err)
 		return nil, err
 	}
 
-	return &User{ID: id, Name: name, Email: email, Password: password, CreatedAt: createdAt, UpdatedAt: updatedAt}, nil
+	return &User{ID: id, Name: name, Email: email, Password: password, EmailVerifiedAt: emailVerifiedAt, CreatedAt: createdAt, UpdatedAt: updatedAt}, nil
+}
+
+// IsUserPassword reports whether the password is the one of the user.
+func IsUserPassword(db SQLExecutor, userId UserId, password Password) (bool, error) {
+	var hashedPassword HashedPassword
+	if err := db.QueryRow(queries.GetUserPasswordById, userId).Scan(&hashedPassword); err != nil {
+		slog.Error("Failed to get password", "err", err)
+		return false, err
+	}
+	return IsSamePassword(db, hashedPassword, password) == nil, nil
+}
+
+// UpdateUserName changes the name of the user and returns the updated user.
+func UpdateUserName(db SQLExecutor, userId UserId, name UserName) (*User, error) {
+	name = UserName(strings.TrimSpace(string(name)))
+	if err := validateUserName(name); err != nil {
+		return nil, err
+	}
+
+	if _, err := db.Exec(queries.UpdateUserName, name, time.Now(), userId); err != nil {
+		slog.Error("Failed to update user name", "err", err)
+		return nil, err
+	}
+	return GetUserById(db, userId)
+}
+
+// ChangePassword sets the new password and revokes every token issued to the
+// user until now, so that other sessions are logged out.
+func ChangePassword(db SQLExecutor, userId UserId, password Password) error {
+	if err := ValidatePassword(password); err != nil {
+		return err
+	}
+	hashedPassword, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	return WithTx(db, func(tx SQLExecutor) error {
+		now := time.Now()
+		if _, err := tx.Exec(queries.UpdateUserPassword, hashedPassword, now, userId); err != nil {
+			slog.Error("Failed to update password", "err", err)
+			return err
+		}
+		return RevokeAllTokens(tx, userId, now)
+	})
+}
+
+// RequestEmailChange keeps the new email as pending and returns a token to
+// send to it. The email is changed only when ConfirmEmailChange is called
+// with the token, so a typo cannot lock the user out.
+func RequestEmailChange(db SQLExecutor, userId UserId, email Email) (string, error) {
+	email = Email(strings.TrimSpace(string(email)))
+	if err := validateEmail(email); err != nil {
+		return "", err
+	}
+
+	var token string
+	err := WithTx(db, func(tx SQLExecutor) error {
+		user, err := GetUserById(tx, userId)
+		if err != nil {
+			return err
+		}
+		if strings.EqualFold(string(user.Email), string(email)) {
+			return ErrEmailUnchanged
+		}
+		duplicate, err := IsDuplicateEmail(tx, email)
+		if err != nil {
+			return err
+		}
+		if duplicate {
+			return ErrEmailAlreadyUsed
+		}
+
+		if _, err := tx.Exec(queries.UpdateUserPendingEmail, email, time.Now(), userId); err != nil {
+			slog.Error("Failed to update pending email", "err", err)
+			return err
+		}
+		token, err = IssueUserToken(tx, userId, UserTokenPurposeChangeEmail)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// ConfirmEmailChange consumes an email change token and replaces the email of
+// its owner with the pending one, which is verified by then.
+func ConfirmEmailChange(db SQLExecutor, plainToken string) (UserId, error) {
+	var userId UserId
+	err := WithTx(db, func(tx SQLExecutor) error {
+		var err error
+		userId, err = consumeUserToken(tx, plainToken, UserTokenPurposeChangeEmail)
+		if err != nil {
+			return err
+		}
+
+		var pendingEmail *Email
+		if err := tx.QueryRow(queries.GetUserPendingEmailForUpdate, userId).Scan(&pendingEmail); err != nil {
+			slog.Error("Failed to get pending email", "err", err)
+			return err
+		}
+		if pendingEmail == nil {
+			return ErrInvalidUserToken
+		}
+
+		now := time.Now()
+		if _, err := tx.Exec(queries.UpdateUserEmail, now, now, userId); err != nil {
+			// 申し込んだあとに別のユーザーが登録した
+			if isDuplicateEntryError(err) {
+				return ErrEmailAlreadyUsed
+			}
+			slog.Error("Failed to update email", "err", err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return userId, nil
+}
+
+// IssueAccountDeletionToken returns a short-lived token that DeleteUser
+// requires, so that an account is never deleted by a single request.
+func IssueAccountDeletionToken(db SQLExecutor, userId UserId) (string, error) {
+	return IssueUserToken(db, userId, UserTokenPurposeDeleteAccount)
+}
+
+// DeleteUser deletes the user with the token of IssueAccountDeletionToken.
+// Terms, categories and tokens of the user are deleted by ON DELETE CASCADE.
+func DeleteUser(db SQLExecutor, userId UserId, plainToken string) error {
+	return WithTx(db, func(tx SQLExecutor) error {
+		owner, err := consumeUserToken(tx, plainToken, UserTokenPurposeDeleteAccount)
+		if err != nil {
+			return err
+		}
+		if owner != userId {
+			slog.Warn("Tried to delete an account with a token of another user", "userId", userId)
+			return ErrInvalidUserToken
+		}
+
+		if _, err := tx.Exec(queries.DeleteUser, userId); err != nil {
+			slog.Error("Failed to delete user", "err", err)
+			return err
+		}
+		return nil
+	})
+}
+
+// 名前は文字、数字、空白と "_", "-", "." だけを使える
+func validateUserName(name UserName) error {
+	if name == "" {
+		return ErrUserNameRequired
+	}
+	if utf8.RuneCountInString(string(name)) > maxUserNameLength {
+		return ErrUserNameTooLong
+	}
+	for _, r := range string(name) {
+		if unicode.IsLetter(r) || unicode.IsMark(r) || unicode.IsDigit(r) || strings.ContainsRune(" _-.", r) {
+			continue
+		}
+		return ErrUserNameInvalid
+	}
+	return nil
+}
+
+// 表示名つきの "Name <addr>" は受け付けない
+func validateEmail(email Email) error {
+	if email == "" {
+		return ErrEmailRequired
+	}
+	if len(email) > maxEmailLength {
+		return ErrEmailInvalid
+	}
+	address, err := mail.ParseAddress(string(email))
+	if err != nil || address.Address != string(email) {
+		return ErrEmailInvalid
+	}
+	return nil
+}
+
+// ValidatePassword returns why the password cannot be set, so that callers can
+// reject it before asking for anything else.
+func ValidatePassword(password Password) error {
+	if password == "" {
+		return ErrPasswordRequired
+	}
+	return passwordpolicy.Check(string(password))
+}
+
+func hashPassword(password Password) (HashedPassword, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		slog.Error("Failed to generate hash", "err", err)
+		return "", err
+	}
+	return HashedPassword(hashedPassword), nil
 }
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"database/sql"
+	"strings"
 	"testing"
 	"time"
 
@@ -21,19 +23,19 @@ func TestCreateUser(t *testing.T) {
 			name:     "Normal user registering",
 			username: "example1",
 			email:    "example1@gmail.com",
-			password: "password",
+			password: "correct-horse",
 			wantErr:  false,
 		},
 		{
 			name:     "Empty user name",
 			email:    "example2@gmail.com",
-			password: "password",
+			password: "correct-horse",
 			wantErr:  true,
 		},
 		{
 			name:     "Empty email",
 			username: "example3",
-			password: "password",
+			password: "correct-horse",
 			wantErr:  true,
 		},
 		{
@@ -46,22 +48,50 @@ func TestCreateUser(t *testing.T) {
 			name:     "Dupulicate email",
 			username: "example5",
 			email:    "yamada@example.com",
-			password: "password",
+			password: "correct-horse",
 			wantErr:  true,
 		},
 		{
 			name:     "Duplicate username allowed",
 			username: "example1",
 			email:    "example_duplicate_username@gmail.com",
-			password: "password",
+			password: "correct-horse",
 			wantErr:  false,
 		},
 		{
 			name:     "Invalid email format",
 			username: "invalidemail",
 			email:    "invalid-email",
+			password: "correct-horse",
+			wantErr:  true,
+		},
+		{
+			name:     "Too long user name",
+			username: UserName(strings.Repeat("長", maxUserNameLength+1)),
+			email:    "example6@gmail.com",
+			password: "correct-horse",
+			wantErr:  true,
+		},
+		{
+			name:     "User name with symbols",
+			username: "<script>",
+			email:    "example7@gmail.com",
+			password: "correct-horse",
+			wantErr:  true,
+		},
+		{
+			name:     "Too short password",
+			username: "example8",
+			email:    "example8@gmail.com",
+			password: "k7#vQm2",
+			wantErr:  true,
+		},
+		{
+			name:     "Common password",
+			username: "example9",
+			email:    "example9@gmail.com",
 			password: "password",
-			wantErr:  false,
+			wantErr:  true,
 		},
 	}
 
@@ -70,7 +100,7 @@ func TestCreateUser(t *testing.T) {
 			tx, err := DB.Begin()
 			require.NoError(t, err)
 			defer tx.Rollback()
-			err = CreateUser(tx, tc.username, tc.email, tc.password)
+			_, err = CreateUser(tx, tc.username, tc.email, tc.password)
 
 			if tc.wantErr {
 				assert.Error(t, err, "Expected error, but an error did not occur.")
@@ -262,3 +292,189 @@ func TestIsSamePassword(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestCreateUserFieldErrors(t *testing.T) {
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	_, err = CreateUser(tx, "", "invalid-email", "short")
+	assert.ErrorIs(t, err, ErrUserNameRequired)
+	assert.ErrorIs(t, err, ErrEmailInvalid)
+	assert.ErrorIs(t, err, ErrPasswordTooShort, "Every invalid field should be reported")
+
+	_, err = CreateUser(tx, "duplicate", "yamada@example.com", "correct-horse")
+	assert.ErrorIs(t, err, ErrEmailAlreadyUsed)
+}
+
+func TestUpdateUserName(t *testing.T) {
+	const userId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+
+	testCases := []struct {
+		name     string
+		username UserName
+		wantName UserName
+		wantErr  error
+	}{
+		{name: "Normal name", username: "山田次郎", wantName: "山田次郎"},
+		{name: "Trimmed name", username: "  yamada  ", wantName: "yamada"},
+		{name: "Empty name", username: "   ", wantErr: ErrUserNameRequired},
+		{name: "Too long name", username: UserName(strings.Repeat("あ", maxUserNameLength+1)), wantErr: ErrUserNameTooLong},
+		{name: "Name with symbols", username: "yamada!", wantErr: ErrUserNameInvalid},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+
+			user, err := UpdateUserName(tx, userId, tc.username)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantName, user.Name)
+		})
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	const userId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	refreshToken, err := IssueRefreshToken(tx, userId)
+	require.NoError(t, err)
+
+	err = ChangePassword(tx, userId, "")
+	assert.ErrorIs(t, err, ErrPasswordRequired)
+	err = ChangePassword(tx, userId, "qwertyuiop")
+	assert.ErrorIs(t, err, ErrPasswordCommon)
+
+	err = ChangePassword(tx, userId, "new-password")
+	require.NoError(t, err)
+
+	ok, err := IsUserPassword(tx, userId, "new-password")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = IsUserPassword(tx, userId, "wrong-password")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = RotateRefreshToken(tx, refreshToken)
+	assert.ErrorIs(t, err, ErrInvalidRefreshToken, "Other sessions should be revoked")
+	revoked, err := IsAccessTokenRevoked(tx, "jti", userId, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.True(t, revoked, "Old access tokens should be revoked")
+}
+
+func TestChangeEmail(t *testing.T) {
+	const userId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+
+	testCases := []struct {
+		name    string
+		email   Email
+		wantErr error
+	}{
+		{name: "New email", email: "yamada.new@example.com"},
+		{name: "Empty email", email: " ", wantErr: ErrEmailRequired},
+		{name: "Invalid email", email: "Yamada <yamada.new@example.com>", wantErr: ErrEmailInvalid},
+		{name: "Same email", email: "YAMADA@example.com", wantErr: ErrEmailUnchanged},
+		{name: "Email of another user", email: "sato@example.com", wantErr: ErrEmailAlreadyUsed},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+
+			token, err := RequestEmailChange(tx, userId, tc.email)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			user, err := GetUserById(tx, userId)
+			require.NoError(t, err)
+			assert.Equal(t, Email("yamada@example.com"), user.Email, "Email should not change until confirmed")
+			require.NotNil(t, user.PendingEmail)
+			assert.Equal(t, tc.email, *user.PendingEmail)
+
+			_, err = VerifyEmail(tx, token)
+			assert.ErrorIs(t, err, ErrInvalidUserToken, "Token should only change the email")
+
+			confirmedUserId, err := ConfirmEmailChange(tx, token)
+			require.NoError(t, err)
+			assert.Equal(t, userId, confirmedUserId)
+
+			user, err = GetUserById(tx, userId)
+			require.NoError(t, err)
+			assert.Equal(t, tc.email, user.Email)
+			assert.Nil(t, user.PendingEmail)
+			assert.NotNil(t, user.EmailVerifiedAt)
+
+			_, err = ConfirmEmailChange(tx, token)
+			assert.ErrorIs(t, err, ErrInvalidUserToken, "Token should be single-use")
+		})
+	}
+}
+
+func TestConfirmEmailChangeTakenMeanwhile(t *testing.T) {
+	const userId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	token, err := RequestEmailChange(tx, userId, "taken@example.com")
+	require.NoError(t, err)
+	_, err = CreateUser(tx, "other", "taken@example.com", "correct-horse")
+	require.NoError(t, err)
+
+	_, err = ConfirmEmailChange(tx, token)
+	assert.ErrorIs(t, err, ErrEmailAlreadyUsed)
+}
+
+func TestDeleteUser(t *testing.T) {
+	const userId = UserId("01HGDJ5HXZD3K6WFYS9JU0A1XG")
+	const otherUserId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	err = DeleteUser(tx, userId, "unknown-token")
+	assert.ErrorIs(t, err, ErrInvalidUserToken)
+
+	otherToken, err := IssueAccountDeletionToken(tx, otherUserId)
+	require.NoError(t, err)
+	err = DeleteUser(tx, userId, otherToken)
+	assert.ErrorIs(t, err, ErrInvalidUserToken, "Token of another user should not delete the user")
+
+	token, err := IssueAccountDeletionToken(tx, userId)
+	require.NoError(t, err)
+	err = DeleteUser(tx, userId, token)
+	require.NoError(t, err)
+
+	_, err = GetUserById(tx, userId)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	var terms, categories int
+	require.NoError(t, tx.QueryRow("SELECT COUNT(*) FROM terms WHERE fk_user_id = ?", userId).Scan(&terms))
+	require.NoError(t, tx.QueryRow("SELECT COUNT(*) FROM categories WHERE fk_user_id = ?", userId).Scan(&categories))
+	assert.Zero(t, terms, "Terms should be deleted with the user")
+	assert.Zero(t, categories, "Categories should be deleted with the user")
+
+	revoked, err := IsAccessTokenRevoked(tx, "jti", userId, time.Now())
+	require.NoError(t, err)
+	assert.True(t, revoked, "Access tokens of a deleted user should be revoked")
+
+	_, err = GetUserById(tx, otherUserId)
+	assert.NoError(t, err, "Other users should be kept")
+}
@@ -0,0 +1,174 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/takuchi17/term-keeper/app/models/queries"
+)
+
+// UserTokenPurpose is what a single-use token sent by mail, or handed out
+// during a two-step operation such as login, can be used for.
+type UserTokenPurpose string
+
+const (
+	UserTokenPurposeVerifyEmail    UserTokenPurpose = "verify_email"
+	UserTokenPurposeResetPassword  UserTokenPurpose = "reset_password"
+	UserTokenPurposeLoginChallenge UserTokenPurpose = "login_challenge"
+	UserTokenPurposeChangeEmail    UserTokenPurpose = "change_email"
+	UserTokenPurposeDeleteAccount  UserTokenPurpose = "delete_account"
+)
+
+// 用途ごとの有効期限
+var userTokenTTLs = map[UserTokenPurpose]time.Duration{
+	UserTokenPurposeVerifyEmail:    24 * time.Hour,
+	UserTokenPurposeResetPassword:  time.Hour,
+	UserTokenPurposeLoginChallenge: LoginChallengeTTL,
+	UserTokenPurposeChangeEmail:    24 * time.Hour,
+	UserTokenPurposeDeleteAccount:  AccountDeletionTTL,
+}
+
+// LoginChallengeTTL is how long the second step of a login can be completed.
+const LoginChallengeTTL = 5 * time.Minute
+
+// AccountDeletionTTL is how long the deletion of an account can be confirmed.
+const AccountDeletionTTL = 10 * time.Minute
+
+var ErrInvalidUserToken = errors.New("invalid or expired token")
+
+// IssueUserToken creates a single-use token for the purpose and returns the
+// plain token to be sent by mail. Unused tokens issued before for the same
+// purpose are invalidated so only the latest mail works.
+func IssueUserToken(db SQLExecutor, userId UserId, purpose UserTokenPurpose) (string, error) {
+	ttl, ok := userTokenTTLs[purpose]
+	if !ok {
+		return "", errors.New("unknown user token purpose: " + string(purpose))
+	}
+
+	plainToken, err := generateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	err = WithTx(db, func(tx SQLExecutor) error {
+		now := time.Now()
+		if _, err := tx.Exec(queries.MarkUserTokensUsedByUserId, now, userId, purpose); err != nil {
+			slog.Error("Failed to invalidate old user tokens", "err", err)
+			return err
+		}
+		if _, err := tx.Exec(queries.CreateUserToken, newULID(), userId, purpose, hashToken(plainToken), now.Add(ttl), now); err != nil {
+			slog.Error("Failed to create user token", "err", err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return plainToken, nil
+}
+
+// VerifyEmail consumes an email verification token and marks the email of
+// its owner as verified.
+func VerifyEmail(db SQLExecutor, plainToken string) (UserId, error) {
+	var userId UserId
+	err := WithTx(db, func(tx SQLExecutor) error {
+		var err error
+		userId, err = consumeUserToken(tx, plainToken, UserTokenPurposeVerifyEmail)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(queries.UpdateUserEmailVerifiedAt, time.Now(), userId); err != nil {
+			slog.Error("Failed to verify email", "err", err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return userId, nil
+}
+
+// ResetPassword consumes a password reset token and sets the new password. All
+// tokens issued to the user before are revoked so old sessions are logged out.
+func ResetPassword(db SQLExecutor, plainToken string, password Password) error {
+	if err := ValidatePassword(password); err != nil {
+		return err
+	}
+	hashedPassword, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	return WithTx(db, func(tx SQLExecutor) error {
+		userId, err := consumeUserToken(tx, plainToken, UserTokenPurposeResetPassword)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if _, err := tx.Exec(queries.UpdateUserPassword, hashedPassword, now, userId); err != nil {
+			slog.Error("Failed to update password", "err", err)
+			return err
+		}
+		// リセットできたならメールアドレスの持ち主なので確認済みにする
+		if _, err := tx.Exec(queries.UpdateUserEmailVerifiedAt, now, userId); err != nil {
+			slog.Error("Failed to verify email", "err", err)
+			return err
+		}
+		return RevokeAllTokens(tx, userId, now)
+	})
+}
+
+func consumeUserToken(db SQLExecutor, plainToken string, purpose UserTokenPurpose) (UserId, error) {
+	id, userId, err := getValidUserToken(db, queries.GetUserTokenByHashForUpdate, plainToken, purpose)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := db.Exec(queries.MarkUserTokenUsed, time.Now(), id); err != nil {
+		slog.Error("Failed to mark user token used", "err", err)
+		return "", err
+	}
+	return userId, nil
+}
+
+// 使われておらず期限内のトークンの ID と持ち主を返す
+func getValidUserToken(db SQLExecutor, query string, plainToken string, purpose UserTokenPurpose) (string, UserId, error) {
+	var (
+		id        string
+		userId    UserId
+		expiresAt time.Time
+		usedAt    *time.Time
+	)
+	err := db.QueryRow(query, hashToken(plainToken), purpose).Scan(&id, &userId, &expiresAt, &usedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", "", ErrInvalidUserToken
+	}
+	if err != nil {
+		slog.Error("Failed to get user token", "err", err)
+		return "", "", err
+	}
+
+	if usedAt != nil || !time.Now().Before(expiresAt) {
+		return "", "", ErrInvalidUserToken
+	}
+	return id, userId, nil
+}
+
+// PurgeExpiredUserTokens deletes the user tokens that expired before the given
+// time, used or not, batchSize rows at a time, and returns how many were deleted.
+func PurgeExpiredUserTokens(db SQLExecutor, before time.Time, batchSize int) (int, error) {
+	deleted, err := purgeInBatches(db, queries.PurgeUserTokensExpiredBefore, before, batchSize)
+	if err != nil {
+		slog.Error("Failed to purge expired user tokens", "err", err)
+		return deleted, err
+	}
+	return deleted, nil
+}
@@ -0,0 +1,147 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestVerifyEmail(t *testing.T) {
+	// 鈴木はメール未確認
+	const userId = UserId("01HGDJ5J8KF4L7XGZT0KV1B2YH")
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	user, err := GetUserById(tx, userId)
+	require.NoError(t, err)
+	require.Nil(t, user.EmailVerifiedAt, "User should not be verified yet")
+
+	oldToken, err := IssueUserToken(tx, userId, UserTokenPurposeVerifyEmail)
+	require.NoError(t, err)
+	token, err := IssueUserToken(tx, userId, UserTokenPurposeVerifyEmail)
+	require.NoError(t, err)
+
+	_, err = VerifyEmail(tx, oldToken)
+	assert.ErrorIs(t, err, ErrInvalidUserToken, "Older token should be invalidated")
+
+	verifiedUserId, err := VerifyEmail(tx, token)
+	require.NoError(t, err)
+	assert.Equal(t, userId, verifiedUserId)
+
+	user, err = GetUserById(tx, userId)
+	require.NoError(t, err)
+	assert.NotNil(t, user.EmailVerifiedAt, "User should be verified")
+
+	_, err = VerifyEmail(tx, token)
+	assert.ErrorIs(t, err, ErrInvalidUserToken, "Token should be single-use")
+}
+
+func TestResetPassword(t *testing.T) {
+	const userId = UserId("01HGDJ5GZRJ2J5VEXR8HT8V9WF")
+
+	testCases := []struct {
+		name     string
+		purpose  UserTokenPurpose
+		token    func(token string) string
+		password Password
+		wantErr  error
+	}{
+		{
+			name:     "Reset with a valid token",
+			purpose:  UserTokenPurposeResetPassword,
+			password: "new-password",
+		},
+		{
+			name:     "Unknown token",
+			purpose:  UserTokenPurposeResetPassword,
+			token:    func(string) string { return "unknown-token" },
+			password: "new-password",
+			wantErr:  ErrInvalidUserToken,
+		},
+		{
+			name:     "Token for another purpose",
+			purpose:  UserTokenPurposeVerifyEmail,
+			password: "new-password",
+			wantErr:  ErrInvalidUserToken,
+		},
+		{
+			name:    "Empty password",
+			purpose: UserTokenPurposeResetPassword,
+			wantErr: ErrPasswordRequired,
+		},
+		{
+			name:     "Common password",
+			purpose:  UserTokenPurposeResetPassword,
+			password: "password123",
+			wantErr:  ErrPasswordCommon,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := DB.Begin()
+			require.NoError(t, err)
+			defer tx.Rollback()
+
+			token, err := IssueUserToken(tx, userId, tc.purpose)
+			require.NoError(t, err)
+			if tc.token != nil {
+				token = tc.token(token)
+			}
+			refreshToken, err := IssueRefreshToken(tx, userId)
+			require.NoError(t, err)
+
+			err = ResetPassword(tx, token, tc.password)
+
+			user, getErr := GetUserByEmail(tx, "yamada@example.com")
+			require.NoError(t, getErr)
+			samePassword := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(tc.password)) == nil
+
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.False(t, samePassword, "Password should not be changed")
+				return
+			}
+
+			assert.NoError(t, err, "Expected no error, but an error occurred.")
+			assert.True(t, samePassword, "Password should be changed")
+
+			_, _, err = RotateRefreshToken(tx, refreshToken)
+			assert.ErrorIs(t, err, ErrInvalidRefreshToken, "Old sessions should be revoked")
+			revoked, err := IsAccessTokenRevoked(tx, "jti", userId, time.Now().Add(-time.Minute))
+			assert.NoError(t, err)
+			assert.True(t, revoked, "Old access tokens should be revoked")
+
+			err = ResetPassword(tx, token, "another-password")
+			assert.ErrorIs(t, err, ErrInvalidUserToken, "Token should be single-use")
+		})
+	}
+}
+
+func TestPurgeExpiredUserTokens(t *testing.T) {
+	const userId = UserId("01HGDJ5J8KF4L7XGZT0KV1B2YH")
+
+	tx, err := DB.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	now := time.Now()
+	expired, err := IssueUserToken(tx, userId, UserTokenPurposeResetPassword)
+	require.NoError(t, err)
+	_, err = tx.Exec(`UPDATE user_tokens SET expires_at = ? WHERE token_hash = ?`, now.Add(-time.Minute), hashToken(expired))
+	require.NoError(t, err)
+	valid, err := IssueUserToken(tx, userId, UserTokenPurposeVerifyEmail)
+	require.NoError(t, err)
+
+	deleted, err := PurgeExpiredUserTokens(tx, now, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	_, err = VerifyEmail(tx, valid)
+	assert.NoError(t, err, "Token before its expiry should be kept")
+}
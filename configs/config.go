@@ -1,9 +1,11 @@
 package configs
 
 import (
+	"errors"
 	"log/slog"
 	"os"
 	"strconv"
+	"strings"
 )
 
 type ConfigList struct {
@@ -15,10 +17,43 @@ type ConfigList struct {
 	DBPassword           string
 	APICorsAllowsOrigins []string
 	JWTSecret            string
+	// 指定すると JWT を RS256 / EdDSA で署名する。公開鍵はローテーション前の鍵
+	JWTPrivateKeyFile string
+	JWTPublicKeyFiles []string
+	// メール送信の設定。MailerType が "smtp" 以外なら MailOutboxDir にファイルとして書き出す
+	MailerType               string
+	MailFrom                 string
+	MailOutboxDir            string
+	SMTPHost                 string
+	SMTPPort                 int
+	SMTPUser                 string
+	SMTPPassword             string
+	AppBaseURL               string
+	RequireEmailVerification bool
+	// ログイン失敗回数の保存先。"mysql" なら複数インスタンスで共有する
+	LoginLimiterStore string
+	TrustProxyHeaders bool
+	// ログインで session_mode: cookie が指定されたときの Cookie の属性
+	SessionCookieSecure   bool
+	SessionCookieSameSite string
+	SessionCookieDomain   string
+	// 用語のリビジョンを残す数と日数。0 なら制限しない
+	TermRevisionMaxPerTerm int
+	TermRevisionMaxAgeDays int
+	// ゴミ箱に残す日数と、期限切れを削除する間隔。日数が 0 なら削除しない
+	TrashRetentionDays        int
+	TrashPurgeIntervalMinutes int
+	// 期限切れのトークンを削除する間隔。0 なら削除しない
+	ExpiredPurgeIntervalMinutes int
+	// ログイン試行の記録を残す日数。0 なら削除しない
+	LoginAttemptRetentionDays int
 }
 
 var Config ConfigList
 
+// DefaultJWTSecret is only meant for development, see Validate.
+const DefaultJWTSecret = "default-secret"
+
 func (c *ConfigList) IsDevelopment() bool {
 	return c.Env == "development"
 }
@@ -29,15 +64,100 @@ func LoadEnv() error {
 		return nil
 	}
 
+	SMTPPort, err := strconv.Atoi(getEnvDefault("SMTP_PORT", "587"))
+	if err != nil {
+		return err
+	}
+	requireEmailVerification, err := strconv.ParseBool(getEnvDefault("REQUIRE_EMAIL_VERIFICATION", "false"))
+	if err != nil {
+		return err
+	}
+
+	trustProxyHeaders, err := strconv.ParseBool(getEnvDefault("TRUST_PROXY_HEADERS", "false"))
+	if err != nil {
+		return err
+	}
+
+	sessionCookieSecure, err := strconv.ParseBool(getEnvDefault("SESSION_COOKIE_SECURE", "true"))
+	if err != nil {
+		return err
+	}
+
+	termRevisionMaxPerTerm, err := strconv.Atoi(getEnvDefault("TERM_REVISION_MAX_PER_TERM", "50"))
+	if err != nil {
+		return err
+	}
+
+	termRevisionMaxAgeDays, err := strconv.Atoi(getEnvDefault("TERM_REVISION_MAX_AGE_DAYS", "0"))
+	if err != nil {
+		return err
+	}
+
+	trashRetentionDays, err := strconv.Atoi(getEnvDefault("TRASH_RETENTION_DAYS", "30"))
+	if err != nil {
+		return err
+	}
+
+	trashPurgeIntervalMinutes, err := strconv.Atoi(getEnvDefault("TRASH_PURGE_INTERVAL_MINUTES", "60"))
+	if err != nil {
+		return err
+	}
+
+	expiredPurgeIntervalMinutes, err := strconv.Atoi(getEnvDefault("EXPIRED_PURGE_INTERVAL_MINUTES", "60"))
+	if err != nil {
+		return err
+	}
+
+	loginAttemptRetentionDays, err := strconv.Atoi(getEnvDefault("LOGIN_ATTEMPT_RETENTION_DAYS", "90"))
+	if err != nil {
+		return err
+	}
+
 	Config = ConfigList{
-		Env:                  getEnvDefault("APP_ENV", "development"),
-		DBUser:               getEnvDefault("DB_USER", "user"),
-		DBHost:               getEnvDefault("DB_HOST", "localhost"),
-		DBPort:               DBPort,
-		DBName:               getEnvDefault("DB_NAME", "term_keeper_db"),
-		DBPassword:           getEnvDefault("DB_PASSWORD", "password"),
-		APICorsAllowsOrigins: []string{"http://localhost:3001"},
-		JWTSecret:            getEnvDefault("JWT_SECRET", "default-secret"),
+		Env:                         getEnvDefault("APP_ENV", "development"),
+		DBUser:                      getEnvDefault("DB_USER", "user"),
+		DBHost:                      getEnvDefault("DB_HOST", "localhost"),
+		DBPort:                      DBPort,
+		DBName:                      getEnvDefault("DB_NAME", "term_keeper_db"),
+		DBPassword:                  getEnvDefault("DB_PASSWORD", "password"),
+		APICorsAllowsOrigins:        splitList(getEnvDefault("CORS_ALLOWED_ORIGINS", "http://localhost:3000,http://localhost:3001")),
+		JWTSecret:                   getEnvDefault("JWT_SECRET", DefaultJWTSecret),
+		JWTPrivateKeyFile:           getEnvDefault("JWT_PRIVATE_KEY_FILE", ""),
+		JWTPublicKeyFiles:           splitList(getEnvDefault("JWT_PUBLIC_KEY_FILES", "")),
+		MailerType:                  getEnvDefault("MAILER", "file"),
+		MailFrom:                    getEnvDefault("MAIL_FROM", "noreply@term-keeper.local"),
+		MailOutboxDir:               getEnvDefault("MAIL_OUTBOX_DIR", "tmp/outbox"),
+		SMTPHost:                    getEnvDefault("SMTP_HOST", "localhost"),
+		SMTPPort:                    SMTPPort,
+		SMTPUser:                    getEnvDefault("SMTP_USER", ""),
+		SMTPPassword:                getEnvDefault("SMTP_PASSWORD", ""),
+		AppBaseURL:                  getEnvDefault("APP_BASE_URL", "http://localhost:3001"),
+		RequireEmailVerification:    requireEmailVerification,
+		LoginLimiterStore:           getEnvDefault("LOGIN_LIMITER_STORE", "memory"),
+		TrustProxyHeaders:           trustProxyHeaders,
+		SessionCookieSecure:         sessionCookieSecure,
+		SessionCookieSameSite:       getEnvDefault("SESSION_COOKIE_SAMESITE", "lax"),
+		SessionCookieDomain:         getEnvDefault("SESSION_COOKIE_DOMAIN", ""),
+		TermRevisionMaxPerTerm:      termRevisionMaxPerTerm,
+		TermRevisionMaxAgeDays:      termRevisionMaxAgeDays,
+		TrashRetentionDays:          trashRetentionDays,
+		TrashPurgeIntervalMinutes:   trashPurgeIntervalMinutes,
+		ExpiredPurgeIntervalMinutes: expiredPurgeIntervalMinutes,
+		LoginAttemptRetentionDays:   loginAttemptRetentionDays,
+	}
+	return nil
+}
+
+// Validate reports settings that must not be used outside development.
+func (c *ConfigList) Validate() error {
+	if c.IsDevelopment() {
+		return nil
+	}
+	if c.JWTPrivateKeyFile == "" && c.JWTSecret == DefaultJWTSecret {
+		return errors.New("set JWT_PRIVATE_KEY_FILE or change JWT_SECRET from the default outside development")
+	}
+	if !c.SessionCookieSecure {
+		return errors.New("SESSION_COOKIE_SECURE must not be false outside development")
 	}
 	return nil
 }
@@ -57,3 +177,14 @@ func init() {
 	}
 	slog.Debug("Success init env")
 }
+
+// カンマ区切りの値。空の要素は除く
+func splitList(val string) []string {
+	var list []string
+	for _, v := range strings.Split(val, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
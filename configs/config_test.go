@@ -16,5 +16,69 @@ func TestInitEnv(t *testing.T) {
 	assert.Equal(t, "term_keeper_db", Config.DBName)
 	assert.Equal(t, "password", Config.DBPassword)
 	assert.Equal(t, 3307, Config.DBPort)
-	assert.Equal(t, []string{"http://localhost:3001"}, Config.APICorsAllowsOrigins)
+	assert.Equal(t, []string{"http://localhost:3000", "http://localhost:3001"}, Config.APICorsAllowsOrigins)
+	assert.Equal(t, "file", Config.MailerType)
+	assert.Equal(t, 587, Config.SMTPPort)
+	assert.Equal(t, "http://localhost:3001", Config.AppBaseURL)
+	assert.False(t, Config.RequireEmailVerification)
+	assert.Equal(t, "memory", Config.LoginLimiterStore)
+	assert.False(t, Config.TrustProxyHeaders)
+	assert.Equal(t, DefaultJWTSecret, Config.JWTSecret)
+	assert.Empty(t, Config.JWTPrivateKeyFile)
+	assert.Empty(t, Config.JWTPublicKeyFiles)
+	assert.True(t, Config.SessionCookieSecure)
+	assert.Equal(t, "lax", Config.SessionCookieSameSite)
+	assert.Equal(t, 50, Config.TermRevisionMaxPerTerm)
+	assert.Equal(t, 0, Config.TermRevisionMaxAgeDays)
+	assert.Equal(t, 30, Config.TrashRetentionDays)
+	assert.Equal(t, 60, Config.TrashPurgeIntervalMinutes)
+	assert.Equal(t, 60, Config.ExpiredPurgeIntervalMinutes)
+	assert.Equal(t, 90, Config.LoginAttemptRetentionDays)
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		config  ConfigList
+		wantErr bool
+	}{
+		{
+			name:   "Default secret in development",
+			config: ConfigList{Env: "development", JWTSecret: DefaultJWTSecret},
+		},
+		{
+			name:    "Default secret in production",
+			config:  ConfigList{Env: "production", JWTSecret: DefaultJWTSecret},
+			wantErr: true,
+		},
+		{
+			name:   "Own secret in production",
+			config: ConfigList{Env: "production", JWTSecret: "a-long-random-secret", SessionCookieSecure: true},
+		},
+		{
+			name:   "Private key in production",
+			config: ConfigList{Env: "production", JWTSecret: DefaultJWTSecret, JWTPrivateKeyFile: "/run/secrets/jwt.pem", SessionCookieSecure: true},
+		},
+		{
+			name:    "Insecure session cookie in production",
+			config:  ConfigList{Env: "production", JWTSecret: "a-long-random-secret"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	assert.Nil(t, splitList(""))
+	assert.Equal(t, []string{"a.pem", "b.pem"}, splitList(" a.pem, ,b.pem "))
 }
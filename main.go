@@ -1,19 +1,29 @@
 package main
 
 import (
+	"context"
+	"database/sql"
 	"encoding/json"
 	"log"
 	"net/http"
+	"os"
+	"time"
 
 	httpSwagger "github.com/swaggo/http-swagger"
 	"github.com/swaggo/swag"
 
 	"github.com/takuchi17/term-keeper/api"
 	"github.com/takuchi17/term-keeper/app/controllers"
+	"github.com/takuchi17/term-keeper/app/jobs"
+	"github.com/takuchi17/term-keeper/app/migrations"
 	"github.com/takuchi17/term-keeper/app/models"
 	"github.com/takuchi17/term-keeper/configs"
 	"github.com/takuchi17/term-keeper/middleware"
+	"github.com/takuchi17/term-keeper/pkg/http_checker"
+	"github.com/takuchi17/term-keeper/pkg/jwt"
 	"github.com/takuchi17/term-keeper/pkg/logger"
+	"github.com/takuchi17/term-keeper/pkg/mailer"
+	"github.com/takuchi17/term-keeper/pkg/ratelimit"
 )
 
 func main() {
@@ -32,10 +42,38 @@ func main() {
 	}
 	defer db.Close()
 
+	// go run . migrate up|down|status
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := migrations.RunCommand(db, os.Args[2:], os.Stdout); err != nil {
+			log.Fatal("Failed to migrate: ", err)
+		}
+		return
+	}
+
+	if err := configs.Config.Validate(); err != nil {
+		log.Fatal("Invalid config: ", err)
+	}
+
+	// 秘密鍵に切り替えても JWT_SECRET を変えていれば、切り替え前のトークンを期限まで受け付ける
+	hmacSecret := configs.Config.JWTSecret
+	if configs.Config.JWTPrivateKeyFile != "" && hmacSecret == configs.DefaultJWTSecret {
+		hmacSecret = ""
+	}
+	keySet, err := jwt.LoadKeySet(jwt.KeySetOptions{
+		PrivateKeyFile: configs.Config.JWTPrivateKeyFile,
+		PublicKeyFiles: configs.Config.JWTPublicKeyFiles,
+		HMACSecret:     hmacSecret,
+	})
+	if err != nil {
+		log.Fatal("Failed to load JWT keys: ", err)
+	}
+	jwt.SetKeySet(keySet)
+
 	swagger, err := api.GetSwagger()
 	if err != nil {
 		log.Fatal("Failed to generate swagger: ", err)
 	}
+	mux := http.NewServeMux()
 	if configs.Config.IsDevelopment() {
 		swaggerJson, _ := json.Marshal(swagger)
 		var SwaggerInfo = &swag.Spec{
@@ -43,25 +81,121 @@ func main() {
 			SwaggerTemplate:  string(swaggerJson),
 		}
 		swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
-		http.Handle("/swagger/", httpSwagger.WrapHandler)
+		mux.Handle("/swagger/", httpSwagger.WrapHandler)
 	}
 
-	userHandler := &controllers.UserHandeler{DB: db}
-	http.Handle("/api/v1/signup", middleware.CORSMiddleware(http.HandlerFunc(userHandler.Create)))
-	http.Handle("/api/v1/login", middleware.CORSMiddleware(http.HandlerFunc(userHandler.Login)))
-
-	termHandler := &controllers.TermHandler{DB: db}
-	http.Handle("/api/v1/terms", middleware.CORSMiddleware(middleware.AuthMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodPost:
-			termHandler.Create(w, r)
-		case http.MethodGet:
-			termHandler.Get(w, r)
-		default:
-			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
-		}
-	}))))
+	mux.HandleFunc("GET /.well-known/jwks.json", jwt.JWKSHandler)
+
+	// reject requests that do not match api/openapi.yaml before routing them
+	validationMiddleware, err := middleware.OpenAPIValidationMiddleware(swagger, middleware.OpenAPIValidatorOptions{
+		BaseURL:           "/api/v1",
+		ValidateResponses: configs.Config.IsDevelopment(),
+	})
+	if err != nil {
+		log.Fatal("Failed to setup OpenAPI validation: ", err)
+	}
+
+	// revoked access tokens are rejected by looking up the jti and the user's logout-all time
+	authMiddleware := middleware.NewOpenAPIAuthMiddleware(
+		func(jti, userID string, issuedAt time.Time) (bool, error) {
+			return models.IsAccessTokenRevoked(db, jti, models.UserId(userID), issuedAt)
+		},
+		func(plainToken string) (*middleware.PersonalAccessToken, error) {
+			token, err := models.AuthenticatePersonalAccessToken(db, plainToken)
+			if err != nil || token == nil {
+				return nil, err
+			}
+			scopes := make([]string, len(token.Scopes))
+			for i, scope := range token.Scopes {
+				scopes[i] = string(scope)
+			}
+			return &middleware.PersonalAccessToken{
+				ID:       string(token.ID),
+				UserID:   string(token.FKUserId),
+				UserName: string(token.UserName),
+				Scopes:   scopes,
+			}, nil
+		},
+	)
+
+	// ゴミ箱の期限切れの用語とカテゴリを定期的に削除する
+	trashRetention := time.Duration(configs.Config.TrashRetentionDays) * 24 * time.Hour
+	trashPurgeInterval := time.Duration(configs.Config.TrashPurgeIntervalMinutes) * time.Minute
+	if trashRetention > 0 && trashPurgeInterval > 0 {
+		go jobs.NewTrashPurger(db, trashRetention, trashPurgeInterval).Run(context.Background())
+	}
+
+	// 期限切れのトークンと古いログイン試行の記録を定期的に削除する
+	loginAttemptRetention := time.Duration(configs.Config.LoginAttemptRetentionDays) * 24 * time.Hour
+	expiredPurgeInterval := time.Duration(configs.Config.ExpiredPurgeIntervalMinutes) * time.Minute
+	if expiredPurgeInterval > 0 {
+		go jobs.NewExpiredPurger(db, loginAttemptRetention, expiredPurgeInterval).Run(context.Background())
+	}
+
+	// every operation in api/openapi.yaml is routed by the generated server
+	server := controllers.NewServer(db, controllers.ServerOptions{
+		Mailer:                   newMailer(),
+		AppBaseURL:               configs.Config.AppBaseURL,
+		RequireEmailVerification: configs.Config.RequireEmailVerification,
+		LoginLimiterStore:        newLoginLimiterStore(db),
+		SessionCookie: controllers.SessionCookieOptions{
+			Secure:   configs.Config.SessionCookieSecure,
+			SameSite: sessionCookieSameSite(configs.Config.SessionCookieSameSite),
+			Domain:   configs.Config.SessionCookieDomain,
+		},
+		TermRevisionRetention: models.TermRevisionRetention{
+			MaxPerTerm: configs.Config.TermRevisionMaxPerTerm,
+			MaxAge:     time.Duration(configs.Config.TermRevisionMaxAgeDays) * 24 * time.Hour,
+		},
+		TrashRetention: trashRetention,
+	})
+	strictHandler := api.NewStrictHandlerWithOptions(server, nil, api.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc:  http_checker.RequestErrorHandler,
+		ResponseErrorHandlerFunc: http_checker.ResponseErrorHandler,
+	})
+	api.HandlerWithOptions(strictHandler, api.StdHTTPServerOptions{
+		BaseURL:          "/api/v1",
+		BaseRouter:       mux,
+		Middlewares:      []api.MiddlewareFunc{authMiddleware},
+		ErrorHandlerFunc: http_checker.RequestErrorHandler,
+	})
+
+	clientIPMiddleware := middleware.NewClientIPMiddleware(configs.Config.TrustProxyHeaders)
+	corsMiddleware := middleware.NewCORSMiddleware(configs.Config.APICorsAllowsOrigins)
 
 	log.Println("Server is running at http://localhost:8080")
-	log.Fatal(http.ListenAndServe(":8080", nil))
+	log.Fatal(http.ListenAndServe(":8080", corsMiddleware(clientIPMiddleware(validationMiddleware(mux)))))
+}
+
+// 開発環境では SMTP サーバーを用意しなくてよいように、メールをファイルに書き出す
+func newMailer() mailer.Mailer {
+	if configs.Config.MailerType == "smtp" {
+		return &mailer.SMTPMailer{
+			Host:     configs.Config.SMTPHost,
+			Port:     configs.Config.SMTPPort,
+			Username: configs.Config.SMTPUser,
+			Password: configs.Config.SMTPPassword,
+			From:     configs.Config.MailFrom,
+		}
+	}
+	return &mailer.FileMailer{Dir: configs.Config.MailOutboxDir, From: configs.Config.MailFrom}
+}
+
+// 複数インスタンスで動かすときは失敗回数を MySQL で共有する
+func newLoginLimiterStore(db *sql.DB) ratelimit.Store {
+	if configs.Config.LoginLimiterStore == "mysql" {
+		return &models.LoginThrottleStore{DB: db}
+	}
+	return ratelimit.NewMemoryStore()
+}
+
+// フロントエンドと API が別サイトなら none にする (Secure が必要)
+func sessionCookieSameSite(value string) http.SameSite {
+	switch value {
+	case "strict":
+		return http.SameSiteStrictMode
+	case "none":
+		return http.SameSiteNoneMode
+	}
+	return http.SameSiteLaxMode
 }
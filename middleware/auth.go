@@ -4,10 +4,13 @@ import (
 	"context"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
+	"time"
 
-	"github.com/golang-jwt/jwt/v5"
-	"github.com/takuchi17/term-keeper/configs"
+	"github.com/takuchi17/term-keeper/api"
+	"github.com/takuchi17/term-keeper/pkg/http_checker"
+	appjwt "github.com/takuchi17/term-keeper/pkg/jwt"
 )
 
 type contextKey struct {
@@ -15,66 +18,188 @@ type contextKey struct {
 }
 
 var (
-	userIDKey   = &contextKey{"userID"}
-	userNameKey = &contextKey{"userName"}
+	userIDKey         = &contextKey{"userID"}
+	userNameKey       = &contextKey{"userName"}
+	tokenIDKey        = &contextKey{"tokenID"}
+	tokenExpiresAtKey = &contextKey{"tokenExpiresAt"}
 )
 
-var jwtSecret = []byte(configs.Config.JWTSecret)
+// TokenRevokedFunc reports whether an access token was revoked before it
+// expired, e.g. by logout.
+type TokenRevokedFunc func(jti string, userID string, issuedAt time.Time) (bool, error)
 
-func AuthMiddleware(next http.Handler) http.Handler {
+// PersonalAccessTokenPrefix starts every personal access token, see
+// models.PersonalAccessTokenPrefix.
+const PersonalAccessTokenPrefix = "tkp_"
+
+// PersonalAccessToken is the part of a personal access token the middleware
+// needs to authenticate a request.
+type PersonalAccessToken struct {
+	ID       string
+	UserID   string
+	UserName string
+	Scopes   []string
+}
+
+// PersonalAccessTokenFunc looks up a personal access token. It returns nil for
+// unknown, expired and revoked tokens.
+type PersonalAccessTokenFunc func(token string) (*PersonalAccessToken, error)
+
+// NewAuthMiddleware authenticates requests by their bearer access token, or by
+// the session cookie with a CSRF token, and rejects tokens for which isRevoked
+// returns true. Personal access tokens are
+// looked up by lookupToken and only accepted for operations that declare
+// scopes, see authenticatePersonalAccessToken.
+func NewAuthMiddleware(isRevoked TokenRevokedFunc, lookupToken PersonalAccessTokenFunc) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return authMiddleware(next, isRevoked, lookupToken)
+	}
+}
+
+func authMiddleware(next http.Handler, isRevoked TokenRevokedFunc, lookupToken PersonalAccessTokenFunc) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// get the Authorization header
+		// get the Authorization header, or the session cookie of browsers
 		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
+		var tokenStr string
+		if authHeader != "" {
+			if !strings.HasPrefix(authHeader, "Bearer ") {
+				slog.Warn("Authorization header is not a bearer token")
+				http_checker.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+				return
+			}
+			tokenStr = strings.TrimPrefix(authHeader, "Bearer ")
+			if strings.HasPrefix(tokenStr, PersonalAccessTokenPrefix) {
+				authenticatePersonalAccessToken(w, r, next, tokenStr, lookupToken)
+				return
+			}
+		} else if cookie, err := r.Cookie(SessionCookieName); err == nil && cookie.Value != "" {
+			// the browser sends the cookie on requests from other sites too
+			if !IsSafeMethod(r.Method) && !hasValidCSRFToken(r) {
+				slog.Warn("Invalid CSRF token", "method", r.Method, "path", r.URL.Path)
+				http_checker.WriteError(w, http.StatusForbidden, "Invalid CSRF token")
+				return
+			}
+			tokenStr = cookie.Value
+		} else {
 			slog.Warn("Authorization header is missing")
-			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			http_checker.WriteError(w, http.StatusUnauthorized, "Unauthorized")
 			return
 		}
-		tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-
-		// parse the token
-		token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-			// Validate the algorithm
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				slog.Warn("Unexpected signing method")
-				return nil, jwt.ErrSignatureInvalid
-			}
-			return jwtSecret, nil
-		})
 
+		// verify the signature with the key of the kid and the expiry
+		claims, err := appjwt.ParseToken(tokenStr)
 		if err != nil {
 			slog.Warn("Failed to parse token", "err", err)
-			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			http_checker.WriteError(w, http.StatusUnauthorized, "Unauthorized")
 			return
 		}
 
-		if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-			// Extract user ID and username from claims
-			userID, ok := claims["userid"].(string)
-			if !ok {
-				slog.Warn("Invalid user ID in token claims")
-				http.Error(w, "Unauthorized", http.StatusUnauthorized)
-				return
-			}
-			userName, ok := claims["username"].(string)
-			if !ok {
-				slog.Warn("Invalid username in token claims")
-				http.Error(w, "Unauthorized", http.StatusUnauthorized)
-				return
-			}
-			// Store user ID and username in request context
-			ctx := context.WithValue(r.Context(), userIDKey, userID)
-			ctx = context.WithValue(ctx, userNameKey, userName)
+		// Extract user ID and username from claims
+		userID, ok := claims["userid"].(string)
+		if !ok {
+			slog.Warn("Invalid user ID in token claims")
+			http_checker.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+		userName, ok := claims["username"].(string)
+		if !ok {
+			slog.Warn("Invalid username in token claims")
+			http_checker.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+		// revocation is looked up by jti, so tokens without one are not accepted
+		tokenID, ok := claims["jti"].(string)
+		if !ok || tokenID == "" {
+			slog.Warn("Invalid jti in token claims")
+			http_checker.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+		issuedAt, err := appjwt.IssuedAt(claims)
+		if err != nil {
+			slog.Warn("Invalid iat in token claims")
+			http_checker.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+		expiresAt, err := claims.GetExpirationTime()
+		if err != nil || expiresAt == nil {
+			slog.Warn("Invalid exp in token claims")
+			http_checker.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
 
-			next.ServeHTTP(w, r.WithContext(ctx))
+		revoked, err := isRevoked(tokenID, userID, issuedAt)
+		if err != nil {
+			slog.Error("Failed to check token revocation", "err", err)
+			http_checker.WriteError(w, http.StatusInternalServerError, "Internal server error")
 			return
 		}
+		if revoked {
+			slog.Warn("Revoked token", "jti", tokenID, "userId", userID)
+			http_checker.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+
+		// Store user ID and username in request context
+		ctx := context.WithValue(r.Context(), userIDKey, userID)
+		ctx = context.WithValue(ctx, userNameKey, userName)
+		ctx = context.WithValue(ctx, tokenIDKey, tokenID)
+		ctx = context.WithValue(ctx, tokenExpiresAtKey, expiresAt.Time)
 
-		slog.Warn("Invalid token")
-		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// authenticatePersonalAccessToken accepts a personal access token only when
+// the operation declares scopes in api/openapi.yaml and the token has all of
+// them. Operations without scopes, such as managing tokens, need a login.
+func authenticatePersonalAccessToken(w http.ResponseWriter, r *http.Request, next http.Handler, tokenStr string, lookupToken PersonalAccessTokenFunc) {
+	token, err := lookupToken(tokenStr)
+	if err != nil {
+		slog.Error("Failed to look up personal access token", "err", err)
+		http_checker.WriteError(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+	if token == nil {
+		slog.Warn("Invalid personal access token")
+		http_checker.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	requiredScopes, _ := r.Context().Value(api.BearerAuthScopes).([]string)
+	if len(requiredScopes) == 0 {
+		slog.Warn("Personal access token used for an operation without scopes", "tokenId", token.ID, "path", r.URL.Path)
+		http_checker.WriteError(w, http.StatusForbidden, "Personal access tokens cannot be used for this operation")
+		return
+	}
+	for _, scope := range requiredScopes {
+		if !slices.Contains(token.Scopes, scope) {
+			slog.Warn("Personal access token lacks scope", "tokenId", token.ID, "scope", scope)
+			http_checker.WriteError(w, http.StatusForbidden, "Insufficient scope: "+scope)
+			return
+		}
+	}
+
+	ctx := context.WithValue(r.Context(), userIDKey, token.UserID)
+	ctx = context.WithValue(ctx, userNameKey, token.UserName)
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// NewOpenAPIAuthMiddleware applies the auth middleware only to operations that
+// declare bearerAuth security in api/openapi.yaml. The generated server marks
+// those requests by putting api.BearerAuthScopes into the context.
+func NewOpenAPIAuthMiddleware(isRevoked TokenRevokedFunc, lookupToken PersonalAccessTokenFunc) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		authenticated := authMiddleware(next, isRevoked, lookupToken)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, ok := r.Context().Value(api.BearerAuthScopes).([]string); ok {
+				authenticated.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 func GetUserID(ctx context.Context) (string, bool) {
 	userId, ok := ctx.Value(userIDKey).(string)
 	return userId, ok
@@ -84,3 +209,15 @@ func GetUserName(ctx context.Context) (string, bool) {
 	name, ok := ctx.Value(userNameKey).(string)
 	return name, ok
 }
+
+// GetTokenID returns the jti of the access token of the request.
+func GetTokenID(ctx context.Context) (string, bool) {
+	tokenID, ok := ctx.Value(tokenIDKey).(string)
+	return tokenID, ok
+}
+
+// GetTokenExpiresAt returns when the access token of the request expires.
+func GetTokenExpiresAt(ctx context.Context) (time.Time, bool) {
+	expiresAt, ok := ctx.Value(tokenExpiresAtKey).(time.Time)
+	return expiresAt, ok
+}
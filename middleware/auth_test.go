@@ -2,19 +2,22 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/takuchi17/term-keeper/api"
 	"github.com/takuchi17/term-keeper/configs"
+	appjwt "github.com/takuchi17/term-keeper/pkg/jwt"
 )
 
 func TestAuthMiddleware(t *testing.T) {
 	// テスト用のシークレットキーを設定
 	configs.Config.JWTSecret = "test-secret-key"
-	jwtSecret = []byte(configs.Config.JWTSecret)
+	appjwt.SetKeySet(appjwt.NewHMACKeySet(configs.Config.JWTSecret))
 
 	// テーブル駆動テスト
 	tests := []struct {
@@ -46,6 +49,38 @@ func TestAuthMiddleware(t *testing.T) {
 			token:          generateExpiredToken(t, "user123", "テストユーザー"),
 			expectedStatus: http.StatusUnauthorized,
 		},
+		{
+			name: "jti のないトークン",
+			token: generateTokenWithClaims(t, jwt.MapClaims{
+				"userid":   "user123",
+				"username": "テストユーザー",
+				"iat":      time.Now().Unix(),
+				"exp":      time.Now().Add(time.Hour).Unix(),
+			}),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "無効化されたトークン",
+			token: generateTokenWithClaims(t, jwt.MapClaims{
+				"jti":      revokedTokenID,
+				"userid":   "user123",
+				"username": "テストユーザー",
+				"iat":      time.Now().Unix(),
+				"exp":      time.Now().Add(time.Hour).Unix(),
+			}),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "無効化の確認に失敗",
+			token: generateTokenWithClaims(t, jwt.MapClaims{
+				"jti":      "broken-jti",
+				"userid":   "user123",
+				"username": "テストユーザー",
+				"iat":      time.Now().Unix(),
+				"exp":      time.Now().Add(time.Hour).Unix(),
+			}),
+			expectedStatus: http.StatusInternalServerError,
+		},
 	}
 
 	for _, tt := range tests {
@@ -73,7 +108,7 @@ func TestAuthMiddleware(t *testing.T) {
 			})
 
 			// ミドルウェアをテストハンドラーに適用
-			handler := AuthMiddleware(testHandler)
+			handler := NewAuthMiddleware(isRevokedForTest, lookupTokenForTest)(testHandler)
 
 			// リクエストを作成
 			req := httptest.NewRequest("GET", "/", nil)
@@ -95,31 +130,63 @@ func TestAuthMiddleware(t *testing.T) {
 	}
 }
 
+const revokedTokenID = "revoked-jti"
+
+// revokedTokenID を無効化済みとして扱うテスト用の確認関数
+func isRevokedForTest(jti string, userID string, issuedAt time.Time) (bool, error) {
+	if jti == "broken-jti" {
+		return false, errors.New("denylist is not available")
+	}
+	return jti == revokedTokenID, nil
+}
+
+// テスト用の個人アクセストークン
+const (
+	readOnlyPersonalAccessToken = "tkp_read-only"
+	brokenPersonalAccessToken   = "tkp_broken"
+)
+
+func lookupTokenForTest(token string) (*PersonalAccessToken, error) {
+	switch token {
+	case readOnlyPersonalAccessToken:
+		return &PersonalAccessToken{
+			ID:       "PAT001",
+			UserID:   "user123",
+			UserName: "テストユーザー",
+			Scopes:   []string{"terms:read", "categories:read"},
+		}, nil
+	case brokenPersonalAccessToken:
+		return nil, errors.New("personal access tokens are not available")
+	}
+	return nil, nil
+}
+
 // 有効なトークンを生成するヘルパー関数
 func generateValidToken(t *testing.T, userID string, userName string) string {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+	return generateTokenWithClaims(t, jwt.MapClaims{
+		"jti":      "valid-jti",
 		"userid":   userID,
 		"username": userName,
+		"iat":      time.Now().Unix(),
 		"exp":      time.Now().Add(time.Hour).Unix(),
 	})
-
-	tokenString, err := token.SignedString(jwtSecret)
-	if err != nil {
-		t.Fatalf("Failed to generate token: %v", err)
-	}
-
-	return tokenString
 }
 
 // 期限切れトークンを生成するヘルパー関数
 func generateExpiredToken(t *testing.T, userID string, userName string) string {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+	return generateTokenWithClaims(t, jwt.MapClaims{
+		"jti":      "expired-jti",
 		"userid":   userID,
 		"username": userName,
+		"iat":      time.Now().Add(-2 * time.Hour).Unix(),
 		"exp":      time.Now().Add(-time.Hour).Unix(), // 過去の時間を指定
 	})
+}
 
-	tokenString, err := token.SignedString(jwtSecret)
+func generateTokenWithClaims(t *testing.T, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	tokenString, err := token.SignedString([]byte(configs.Config.JWTSecret))
 	if err != nil {
 		t.Fatalf("Failed to generate token: %v", err)
 	}
@@ -173,3 +240,238 @@ func TestGetUserFunctions(t *testing.T) {
 		}
 	})
 }
+
+// 仕様で bearerAuth が指定された操作だけ認証されることのテスト
+func TestOpenAPIAuthMiddleware(t *testing.T) {
+	configs.Config.JWTSecret = "test-secret-key"
+	appjwt.SetKeySet(appjwt.NewHMACKeySet(configs.Config.JWTSecret))
+
+	tests := []struct {
+		name           string
+		secured        bool
+		token          string
+		expectedStatus int
+	}{
+		{
+			name:           "認証不要な操作はトークンなしで通る",
+			secured:        false,
+			token:          "",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "認証が必要な操作はトークンなしで401",
+			secured:        true,
+			token:          "",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "認証が必要な操作は有効なトークンで通る",
+			secured:        true,
+			token:          generateValidToken(t, "user123", "テストユーザー"),
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			handler := NewOpenAPIAuthMiddleware(isRevokedForTest, lookupTokenForTest)(testHandler)
+
+			req := httptest.NewRequest("GET", "/", nil)
+			if tt.secured {
+				req = req.WithContext(context.WithValue(req.Context(), api.BearerAuthScopes, []string{}))
+			}
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
+
+// 個人アクセストークンは操作のスコープをすべて持つときだけ通ることのテスト
+func TestOpenAPIAuthMiddlewarePersonalAccessToken(t *testing.T) {
+	configs.Config.JWTSecret = "test-secret-key"
+	appjwt.SetKeySet(appjwt.NewHMACKeySet(configs.Config.JWTSecret))
+
+	tests := []struct {
+		name           string
+		scopes         []string
+		token          string
+		expectedStatus int
+	}{
+		{
+			name:           "スコープを持つトークンで通る",
+			scopes:         []string{"terms:read"},
+			token:          readOnlyPersonalAccessToken,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "スコープが足りないと403",
+			scopes:         []string{"terms:write"},
+			token:          readOnlyPersonalAccessToken,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "スコープのない操作は403",
+			scopes:         []string{},
+			token:          readOnlyPersonalAccessToken,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "不明なトークンは401",
+			scopes:         []string{"terms:read"},
+			token:          "tkp_unknown",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "トークンを確認できなければ500",
+			scopes:         []string{"terms:read"},
+			token:          brokenPersonalAccessToken,
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "JWT はスコープに関係なく通る",
+			scopes:         []string{"terms:write"},
+			token:          generateValidToken(t, "user123", "テストユーザー"),
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if userID, ok := GetUserID(r.Context()); !ok || userID != "user123" {
+					t.Errorf("Expected userID user123, got %q", userID)
+				}
+				w.WriteHeader(http.StatusOK)
+			})
+			handler := NewOpenAPIAuthMiddleware(isRevokedForTest, lookupTokenForTest)(testHandler)
+
+			req := httptest.NewRequest("GET", "/", nil)
+			req = req.WithContext(context.WithValue(req.Context(), api.BearerAuthScopes, tt.scopes))
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
+
+// セッション Cookie での認証と CSRF トークンの確認のテスト
+func TestAuthMiddlewareSessionCookie(t *testing.T) {
+	configs.Config.JWTSecret = "test-secret-key"
+	appjwt.SetKeySet(appjwt.NewHMACKeySet(configs.Config.JWTSecret))
+
+	validToken := generateValidToken(t, "user123", "テストユーザー")
+
+	tests := []struct {
+		name           string
+		method         string
+		sessionCookie  string
+		csrfCookie     string
+		csrfHeader     string
+		authorization  string
+		expectedStatus int
+	}{
+		{
+			name:           "GET は CSRF トークンなしで通る",
+			method:         http.MethodGet,
+			sessionCookie:  validToken,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "POST は CSRF トークンが一致すれば通る",
+			method:         http.MethodPost,
+			sessionCookie:  validToken,
+			csrfCookie:     "csrf-token",
+			csrfHeader:     "csrf-token",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "POST で CSRF ヘッダーがなければ403",
+			method:         http.MethodPost,
+			sessionCookie:  validToken,
+			csrfCookie:     "csrf-token",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "DELETE で CSRF トークンが一致しなければ403",
+			method:         http.MethodDelete,
+			sessionCookie:  validToken,
+			csrfCookie:     "csrf-token",
+			csrfHeader:     "other-token",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "CSRF Cookie がなければ403",
+			method:         http.MethodPut,
+			sessionCookie:  validToken,
+			csrfHeader:     "csrf-token",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "不正な Cookie は401",
+			method:         http.MethodGet,
+			sessionCookie:  "invalid-token",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Authorization ヘッダーがあれば CSRF トークンは不要",
+			method:         http.MethodPost,
+			sessionCookie:  "invalid-token",
+			authorization:  "Bearer " + validToken,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Bearer 以外の Authorization ヘッダーは Cookie があっても401",
+			method:         http.MethodGet,
+			sessionCookie:  validToken,
+			authorization:  "Basic dXNlcjpwYXNz",
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if userID, ok := GetUserID(r.Context()); !ok || userID != "user123" {
+					t.Errorf("Expected userID user123, got %q", userID)
+				}
+				w.WriteHeader(http.StatusOK)
+			})
+			handler := NewAuthMiddleware(isRevokedForTest, lookupTokenForTest)(testHandler)
+
+			req := httptest.NewRequest(tt.method, "/", nil)
+			req.AddCookie(&http.Cookie{Name: SessionCookieName, Value: tt.sessionCookie})
+			if tt.csrfCookie != "" {
+				req.AddCookie(&http.Cookie{Name: CSRFCookieName, Value: tt.csrfCookie})
+			}
+			if tt.csrfHeader != "" {
+				req.Header.Set(CSRFHeaderName, tt.csrfHeader)
+			}
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
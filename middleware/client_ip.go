@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+var clientIPKey = &contextKey{"clientIP"}
+
+// NewClientIPMiddleware stores the IP address of the client in the request
+// context. X-Forwarded-For is only trusted when the server runs behind a
+// reverse proxy that sets it, otherwise clients could pick any address.
+func NewClientIPMiddleware(trustProxyHeaders bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := remoteIP(r.RemoteAddr)
+			if trustProxyHeaders {
+				if forwarded := forwardedIP(r.Header.Get("X-Forwarded-For")); forwarded != "" {
+					ip = forwarded
+				}
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientIPKey, ip)))
+		})
+	}
+}
+
+// GetClientIP returns the IP address stored by the client IP middleware.
+func GetClientIP(ctx context.Context) (string, bool) {
+	ip, ok := ctx.Value(clientIPKey).(string)
+	return ip, ok
+}
+
+func remoteIP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
+
+// 最後のプロキシが追加した右端のアドレスを使う
+func forwardedIP(header string) string {
+	if header == "" {
+		return ""
+	}
+	addresses := strings.Split(header, ",")
+	ip := strings.TrimSpace(addresses[len(addresses)-1])
+	if net.ParseIP(ip) == nil {
+		return ""
+	}
+	return ip
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientIPMiddleware(t *testing.T) {
+	tests := []struct {
+		name              string
+		trustProxyHeaders bool
+		remoteAddr        string
+		forwardedFor      string
+		expectedIP        string
+	}{
+		{
+			name:       "接続元のアドレス",
+			remoteAddr: "192.0.2.1:54321",
+			expectedIP: "192.0.2.1",
+		},
+		{
+			name:       "IPv6 の接続元",
+			remoteAddr: "[2001:db8::1]:54321",
+			expectedIP: "2001:db8::1",
+		},
+		{
+			name:         "プロキシを信頼しない場合は X-Forwarded-For を無視",
+			remoteAddr:   "192.0.2.1:54321",
+			forwardedFor: "198.51.100.7",
+			expectedIP:   "192.0.2.1",
+		},
+		{
+			name:              "プロキシを信頼する場合は右端のアドレス",
+			trustProxyHeaders: true,
+			remoteAddr:        "10.0.0.1:54321",
+			forwardedFor:      "203.0.113.9, 198.51.100.7",
+			expectedIP:        "198.51.100.7",
+		},
+		{
+			name:              "不正な X-Forwarded-For",
+			trustProxyHeaders: true,
+			remoteAddr:        "10.0.0.1:54321",
+			forwardedFor:      "unknown",
+			expectedIP:        "10.0.0.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotIP string
+			handler := NewClientIPMiddleware(tt.trustProxyHeaders)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotIP, _ = GetClientIP(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/login", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.forwardedFor != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwardedFor)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			if gotIP != tt.expectedIP {
+				t.Errorf("IP mismatch: got %q, want %q", gotIP, tt.expectedIP)
+			}
+		})
+	}
+}
@@ -1,18 +1,41 @@
 package middleware
 
-import "net/http"
+import (
+	"net/http"
+	"slices"
+	"strconv"
+)
 
-func CORSMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "http://localhost:3000")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+const corsMaxAge = 10 * 60
 
-		if r.Method == http.MethodOptions {
-			w.WriteHeader(http.StatusOK)
-			return
-		}
+// NewCORSMiddleware allows requests with credentials from the allowed origins.
+// Browsers reject credentialed responses with "*", so the origin of the
+// request is echoed back when it is allowed and nothing is sent otherwise.
+func NewCORSMiddleware(allowedOrigins []string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// 許可するかどうかが Origin で変わるので、キャッシュを分けさせる
+			w.Header().Add("Vary", "Origin")
 
-		next.ServeHTTP(w, r)
-	})
+			origin := r.Header.Get("Origin")
+			allowed := origin != "" && slices.Contains(allowedOrigins, origin)
+			if allowed {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+				w.Header().Set("Access-Control-Allow-Credentials", "true")
+				w.Header().Set("Access-Control-Expose-Headers", "Retry-After")
+			}
+
+			if r.Method == http.MethodOptions {
+				if allowed {
+					w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+					w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, "+CSRFHeaderName)
+					w.Header().Set("Access-Control-Max-Age", strconv.Itoa(corsMaxAge))
+				}
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
 }
@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCORSMiddleware(t *testing.T) {
+	allowedOrigins := []string{"http://localhost:3000"}
+
+	tests := []struct {
+		name                string
+		method              string
+		origin              string
+		expectedStatus      int
+		expectedOrigin      string
+		expectedCredentials string
+		expectedHeaders     string
+		expectedNext        bool
+	}{
+		{
+			name:                "許可された Origin は Cookie 付きで許可する",
+			method:              http.MethodGet,
+			origin:              "http://localhost:3000",
+			expectedStatus:      http.StatusOK,
+			expectedOrigin:      "http://localhost:3000",
+			expectedCredentials: "true",
+			expectedNext:        true,
+		},
+		{
+			name:           "許可されていない Origin にはヘッダーを返さない",
+			method:         http.MethodGet,
+			origin:         "https://evil.example.com",
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+		},
+		{
+			name:           "Origin のないリクエストはそのまま通す",
+			method:         http.MethodPost,
+			expectedStatus: http.StatusOK,
+			expectedNext:   true,
+		},
+		{
+			name:                "プリフライトで CSRF ヘッダーを許可する",
+			method:              http.MethodOptions,
+			origin:              "http://localhost:3000",
+			expectedStatus:      http.StatusOK,
+			expectedOrigin:      "http://localhost:3000",
+			expectedCredentials: "true",
+			expectedHeaders:     "Content-Type, Authorization, X-CSRF-Token",
+		},
+		{
+			name:           "許可されていない Origin のプリフライト",
+			method:         http.MethodOptions,
+			origin:         "https://evil.example.com",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusOK)
+			})
+			handler := NewCORSMiddleware(allowedOrigins)(next)
+
+			req := httptest.NewRequest(tt.method, "/api/v1/terms", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatus, w.Code)
+			}
+			if called != tt.expectedNext {
+				t.Errorf("Expected next handler called %v, got %v", tt.expectedNext, called)
+			}
+			if got := w.Header().Get("Access-Control-Allow-Origin"); got != tt.expectedOrigin {
+				t.Errorf("Expected Access-Control-Allow-Origin %q, got %q", tt.expectedOrigin, got)
+			}
+			if got := w.Header().Get("Access-Control-Allow-Credentials"); got != tt.expectedCredentials {
+				t.Errorf("Expected Access-Control-Allow-Credentials %q, got %q", tt.expectedCredentials, got)
+			}
+			if got := w.Header().Get("Access-Control-Allow-Headers"); got != tt.expectedHeaders {
+				t.Errorf("Expected Access-Control-Allow-Headers %q, got %q", tt.expectedHeaders, got)
+			}
+			if got := w.Header().Get("Vary"); got != "Origin" {
+				t.Errorf("Expected Vary Origin, got %q", got)
+			}
+		})
+	}
+}
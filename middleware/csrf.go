@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+)
+
+// Cookies of the cookie session mode, see the description of /login in
+// api/openapi.yaml.
+const (
+	// SessionCookieName holds the access token. It is HttpOnly.
+	SessionCookieName = "tk_session"
+	// RefreshCookieName holds the refresh token. It is HttpOnly.
+	RefreshCookieName = "tk_refresh"
+	// CSRFCookieName holds the CSRF token, which the frontend reads and sends
+	// back in CSRFHeaderName.
+	CSRFCookieName = "tk_csrf"
+	CSRFHeaderName = "X-CSRF-Token"
+)
+
+// IsSafeMethod reports whether the method does not change state, so that it
+// needs no CSRF token.
+func IsSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// ValidCSRFToken reports whether the CSRF header matches the CSRF cookie
+// (double submit). Another site can make the browser send the cookie but
+// cannot read it to set the header.
+func ValidCSRFToken(header string, cookie string) bool {
+	if header == "" || cookie == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(header), []byte(cookie)) == 1
+}
+
+func hasValidCSRFToken(r *http.Request) bool {
+	cookie, err := r.Cookie(CSRFCookieName)
+	if err != nil {
+		return false
+	}
+	return ValidCSRFToken(r.Header.Get(CSRFHeaderName), cookie.Value)
+}
@@ -0,0 +1,191 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/takuchi17/term-keeper/api"
+)
+
+type OpenAPIValidatorOptions struct {
+	// BaseURL is the prefix the operations are served under, e.g. "/api/v1".
+	BaseURL string
+	// ValidateResponses logs responses that do not match the spec.
+	// Responses are buffered for this, so it is meant for development only.
+	ValidateResponses bool
+}
+
+// OpenAPIValidationMiddleware validates path, query and body of every request
+// to an operation of the spec and answers 400 with all violations at once.
+// Requests that do not match any operation are passed through untouched.
+func OpenAPIValidationMiddleware(swagger *openapi3.T, options OpenAPIValidatorOptions) (func(http.Handler) http.Handler, error) {
+	openapi3.DefineStringFormatValidator("email", openapi3.NewRegexpFormatValidator(openapi3.FormatOfStringForEmail))
+
+	// match the operations by path only, whatever host the server runs on
+	doc := *swagger
+	doc.Servers = openapi3.Servers{{URL: options.BaseURL}}
+	router, err := legacy.NewRouter(&doc)
+	if err != nil {
+		return nil, err
+	}
+
+	filterOptions := &openapi3filter.Options{
+		MultiError: true,
+		// authentication is done by AuthMiddleware
+		AuthenticationFunc:    openapi3filter.NoopAuthenticationFunc,
+		IncludeResponseStatus: true,
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams, err := router.FindRoute(r)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			requestInput := &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    filterOptions,
+			}
+			if err := openapi3filter.ValidateRequest(r.Context(), requestInput); err != nil {
+				violations := validationErrors(err)
+				slog.Warn("Request does not match the spec", "method", r.Method, "path", r.URL.Path, "violations", violations)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(api.ErrorResponse{
+					Message: "Request does not match the API specification",
+					Errors:  &violations,
+				})
+				return
+			}
+
+			if !options.ValidateResponses {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			recorder := &responseRecorder{ResponseWriter: w}
+			next.ServeHTTP(recorder, r)
+			validateResponse(r, route, requestInput, recorder)
+		})
+	}, nil
+}
+
+// validateResponse only reports drift from the contract; the response has
+// already been sent as the handler wrote it.
+func validateResponse(r *http.Request, route *routers.Route, requestInput *openapi3filter.RequestValidationInput, recorder *responseRecorder) {
+	status := recorder.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	// server errors are logged by the handlers themselves
+	if status >= http.StatusInternalServerError {
+		return
+	}
+
+	responseInput := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: requestInput,
+		Status:                 status,
+		Header:                 recorder.Header(),
+		Body:                   io.NopCloser(bytes.NewReader(recorder.body.Bytes())),
+		Options:                requestInput.Options,
+	}
+	if err := openapi3filter.ValidateResponse(r.Context(), responseInput); err != nil {
+		slog.Error("Response does not match the spec",
+			"operation", route.Operation.OperationID,
+			"status", status,
+			"err", err,
+		)
+	}
+}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// validationErrors flattens the errors of openapi3filter into one entry per
+// violated parameter or body property.
+func validationErrors(err error) []api.ValidationError {
+	var violations []api.ValidationError
+
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, inner := range e {
+			violations = append(violations, validationErrors(inner)...)
+		}
+	case *openapi3filter.RequestError:
+		switch {
+		case e.Parameter != nil:
+			schemaErrs := schemaErrors(e.Err)
+			if len(schemaErrs) == 0 {
+				violations = append(violations, api.ValidationError{In: e.Parameter.In, Field: e.Parameter.Name, Message: requestErrorReason(e)})
+			}
+			for _, schemaErr := range schemaErrs {
+				violations = append(violations, api.ValidationError{In: e.Parameter.In, Field: e.Parameter.Name, Message: schemaErr.Reason})
+			}
+		case e.RequestBody != nil:
+			schemaErrs := schemaErrors(e.Err)
+			if len(schemaErrs) == 0 {
+				violations = append(violations, api.ValidationError{In: "body", Field: "", Message: requestErrorReason(e)})
+			}
+			for _, schemaErr := range schemaErrs {
+				violations = append(violations, api.ValidationError{In: "body", Field: strings.Join(schemaErr.JSONPointer(), "."), Message: schemaErr.Reason})
+			}
+		default:
+			violations = append(violations, api.ValidationError{In: "", Field: "", Message: e.Error()})
+		}
+	default:
+		violations = append(violations, api.ValidationError{In: "", Field: "", Message: err.Error()})
+	}
+
+	return violations
+}
+
+func schemaErrors(err error) []*openapi3.SchemaError {
+	var schemaErrs []*openapi3.SchemaError
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, inner := range e {
+			schemaErrs = append(schemaErrs, schemaErrors(inner)...)
+		}
+	case *openapi3.SchemaError:
+		schemaErrs = append(schemaErrs, e)
+	}
+	return schemaErrs
+}
+
+func requestErrorReason(err *openapi3filter.RequestError) string {
+	if err.Err == nil {
+		return err.Reason
+	}
+	if err.Reason == "" {
+		return err.Err.Error()
+	}
+	return err.Reason + ": " + err.Err.Error()
+}
@@ -0,0 +1,178 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/takuchi17/term-keeper/api"
+)
+
+func newTestValidator(t *testing.T, validateResponses bool) func(http.Handler) http.Handler {
+	swagger, err := api.GetSwagger()
+	if err != nil {
+		t.Fatalf("Failed to load swagger: %v", err)
+	}
+	validator, err := OpenAPIValidationMiddleware(swagger, OpenAPIValidatorOptions{
+		BaseURL:           "/api/v1",
+		ValidateResponses: validateResponses,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create validator: %v", err)
+	}
+	return validator
+}
+
+func TestOpenAPIValidationMiddleware(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	// テーブル駆動テスト
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expectedStatus int
+		expectedFields []string
+	}{
+		{
+			name:           "正しいクエリ",
+			method:         http.MethodGet,
+			target:         "/api/v1/terms?sort=term_asc&checked=true",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "sort と checked が不正",
+			method:         http.MethodGet,
+			target:         "/api/v1/terms?sort=unknown&checked=maybe",
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: []string{"sort", "checked"},
+		},
+		{
+			name:           "正しいユーザー登録",
+			method:         http.MethodPost,
+			target:         "/api/v1/signup",
+			body:           `{"username":"taro","email":"taro@example.com","password":"password"}`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "必須項目の欠落とメール形式の誤り",
+			method:         http.MethodPost,
+			target:         "/api/v1/signup",
+			body:           `{"email":"not-an-email"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: []string{"username", "password", "email"},
+		},
+		{
+			name:           "長すぎるユーザー名と短すぎるパスワード",
+			method:         http.MethodPost,
+			target:         "/api/v1/signup",
+			body:           `{"username":"123456789012345678901234567890123","email":"taro@example.com","password":"short"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: []string{"username", "password"},
+		},
+		{
+			name:           "不正なカラーコード",
+			method:         http.MethodPost,
+			target:         "/api/v1/categories",
+			body:           `{"name":"Go","hex_color_code":"blue"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: []string{"hex_color_code"},
+		},
+		{
+			name:           "正しい一括操作",
+			method:         http.MethodPost,
+			target:         "/api/v1/terms:batch",
+			body:           `{"mode":"best_effort","operations":[{"op":"create","name":"Go"},{"op":"add_category","id":"TERM001","categoryIds":["CATE001"]}]}`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "一括操作の件数が上限を超える",
+			method:         http.MethodPost,
+			target:         "/api/v1/terms:batch",
+			body:           `{"operations":[` + strings.TrimSuffix(strings.Repeat(`{"op":"delete","id":"TERM001"},`, 101), ",") + `]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedFields: []string{"operations"},
+		},
+		{
+			name:           "仕様にないパスはそのまま通す",
+			method:         http.MethodGet,
+			target:         "/swagger/index.html",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			handler := validator(testHandler)
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("Expected status code %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if tt.expectedStatus != http.StatusBadRequest {
+				return
+			}
+
+			var errorResponse api.ErrorResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &errorResponse); err != nil {
+				t.Fatalf("Failed to decode error response: %v", err)
+			}
+			if errorResponse.Errors == nil {
+				t.Fatal("Expected violations in error response, got none")
+			}
+			if len(*errorResponse.Errors) != len(tt.expectedFields) {
+				t.Fatalf("Expected %d violations, got %d: %+v", len(tt.expectedFields), len(*errorResponse.Errors), *errorResponse.Errors)
+			}
+			for _, expectedField := range tt.expectedFields {
+				found := false
+				for _, violation := range *errorResponse.Errors {
+					if violation.Field == expectedField {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("Expected violation for field %q, got %+v", expectedField, *errorResponse.Errors)
+				}
+			}
+		})
+	}
+}
+
+// レスポンス検証を有効にしてもレスポンスは書き換えないことのテスト
+func TestOpenAPIValidationMiddlewareResponse(t *testing.T) {
+	validator := newTestValidator(t, true)
+
+	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 仕様では JSON 配列を返すはず
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"unexpected":true}`))
+	})
+	handler := validator(testHandler)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/categories", nil)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status code %d, got %d", http.StatusOK, w.Code)
+	}
+	if w.Body.String() != `{"unexpected":true}` {
+		t.Errorf("Expected body to be passed through, got %s", w.Body.String())
+	}
+}
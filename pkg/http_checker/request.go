@@ -2,33 +2,28 @@ package http_checker
 
 import (
 	"encoding/json"
-	"errors"
 	"log/slog"
 	"net/http"
 
 	"github.com/takuchi17/term-keeper/api"
 )
 
-func CheckRequest(w http.ResponseWriter, r *http.Request, requestBodyBuf any) error {
-	// Check if the request method is POST
-	if r.Method != http.MethodPost {
-		slog.Warn("Invalid request method", "method", r.Method)
-		errorResponse := api.ErrorResponse{Message: "Invalid request method"}
-		errorJSON, _ := json.Marshal(errorResponse)
-		w.Header().Set("Content-Type", "application/json")
-		http.Error(w, string(errorJSON), http.StatusMethodNotAllowed)
-		return errors.New("invalid request method")
-	}
+// RequestErrorHandler is used for requests the generated server could not bind
+// to the spec, e.g. an undecodable body or a malformed path/query parameter.
+func RequestErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	slog.Warn("Invalid request", "method", r.Method, "path", r.URL.Path, "err", err)
+	WriteError(w, http.StatusBadRequest, err.Error())
+}
 
-	// Check if the content type is application/json
-	if err := json.NewDecoder(r.Body).Decode(requestBodyBuf); err != nil {
-		slog.Warn("Failed to decode request body", "err", err)
-		errorResponse := api.ErrorResponse{Message: "Invalid request body"}
-		errorJSON, _ := json.Marshal(errorResponse)
-		w.Header().Set("Content-Type", "application/json")
-		http.Error(w, string(errorJSON), http.StatusBadRequest)
-		return errors.New("invalid request body")
-	}
+// ResponseErrorHandler is used when a handler returns an error instead of a
+// response object. The cause is only logged, never sent to the client.
+func ResponseErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	slog.Error("Failed to handle request", "method", r.Method, "path", r.URL.Path, "err", err)
+	WriteError(w, http.StatusInternalServerError, "Internal server error")
+}
 
-	return nil
+func WriteError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(api.ErrorResponse{Message: message})
 }
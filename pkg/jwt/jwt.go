@@ -1,22 +1,90 @@
 package jwt
 
 import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"net/http"
+	"sync"
 	"time"
 
+	"github.com/oklog/ulid/v2"
 	"github.com/takuchi17/term-keeper/app/models"
 	"github.com/takuchi17/term-keeper/configs"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
-var jwtSecret = []byte(configs.Config.JWTSecret)
+// AccessTokenTTL is kept short because access tokens are only revoked by the
+// denylist; clients renew them with a refresh token.
+const AccessTokenTTL = 15 * time.Minute
+
+// 起動時に SetKeySet で差し替える。テストでは JWT_SECRET の HMAC のまま使う
+var (
+	keySetMu sync.RWMutex
+	keySet   = NewHMACKeySet(configs.Config.JWTSecret)
+)
+
+// SetKeySet replaces the keys GenerateToken and ParseToken use.
+func SetKeySet(ks *KeySet) {
+	keySetMu.Lock()
+	defer keySetMu.Unlock()
+	keySet = ks
+}
+
+// GetKeySet returns the keys GenerateToken and ParseToken use.
+func GetKeySet() *KeySet {
+	keySetMu.RLock()
+	defer keySetMu.RUnlock()
+	return keySet
+}
+
+// issuedAtMicroClaim is the issued time in microseconds. iat is in seconds and
+// cannot tell tokens issued just before and just after a logout-all apart.
+const issuedAtMicroClaim = "iat_us"
 
 func GenerateToken(userID models.UserId, userName models.UserName) (string, error) {
+	t := time.Now()
+	entropy := ulid.Monotonic(rand.New(rand.NewSource(t.UnixNano())), 0)
+
 	claims := jwt.MapClaims{
-		"userid":   userID,
-		"username": userName,
-		"exp":      time.Now().Add(time.Hour * 24).Unix(),
+		"jti":              ulid.MustNew(ulid.Timestamp(t), entropy).String(),
+		"userid":           userID,
+		"username":         userName,
+		"iat":              t.Unix(),
+		issuedAtMicroClaim: t.UnixMicro(),
+		"exp":              t.Add(AccessTokenTTL).Unix(),
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(jwtSecret)
+	return GetKeySet().Sign(claims)
+}
+
+// ParseToken verifies the signature and expiry of an access token and returns
+// its claims.
+func ParseToken(tokenStr string) (jwt.MapClaims, error) {
+	return GetKeySet().Parse(tokenStr)
+}
+
+// IssuedAt returns when the access token was issued, in microseconds. Tokens
+// without iat_us fall back to iat.
+func IssuedAt(claims jwt.MapClaims) (time.Time, error) {
+	if micro, ok := claims[issuedAtMicroClaim].(float64); ok {
+		return time.UnixMicro(int64(micro)), nil
+	}
+	issuedAt, err := claims.GetIssuedAt()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if issuedAt == nil {
+		return time.Time{}, errors.New("token has no iat")
+	}
+	return issuedAt.Time, nil
+}
+
+// JWKSHandler serves the public keys at /.well-known/jwks.json so that other
+// services can verify access tokens.
+func JWKSHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	// ローテーションした鍵が行き渡るように短めにキャッシュさせる
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	json.NewEncoder(w).Encode(GetKeySet().JWKS())
 }
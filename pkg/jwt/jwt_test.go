@@ -0,0 +1,74 @@
+package jwt
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateAndParseToken(t *testing.T) {
+	private, _ := newEd25519Files(t, "signing")
+	ks, err := LoadKeySet(KeySetOptions{PrivateKeyFile: private})
+	require.NoError(t, err)
+	SetKeySet(ks)
+	defer SetKeySet(NewHMACKeySet("test-secret"))
+
+	tokenStr, err := GenerateToken("user123", "テストユーザー")
+	require.NoError(t, err)
+
+	claims, err := ParseToken(tokenStr)
+	require.NoError(t, err)
+	assert.Equal(t, "user123", claims["userid"])
+	assert.Equal(t, "テストユーザー", claims["username"])
+	assert.NotEmpty(t, claims["jti"])
+
+	_, err = ParseToken(tokenStr + "x")
+	assert.Error(t, err, "Tampered token should be rejected")
+}
+
+func TestIssuedAt(t *testing.T) {
+	before := time.Now().Truncate(time.Microsecond)
+	tokenStr, err := GenerateToken("user123", "テストユーザー")
+	require.NoError(t, err)
+	after := time.Now()
+
+	claims, err := ParseToken(tokenStr)
+	require.NoError(t, err)
+	issuedAt, err := IssuedAt(claims)
+	require.NoError(t, err)
+	assert.False(t, issuedAt.Before(before), "Issued time should keep microseconds")
+	assert.False(t, issuedAt.After(after))
+
+	// iat_us のない古いトークンは iat を使う
+	legacy, err := IssuedAt(jwt.MapClaims{"iat": float64(1700000000)})
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(1700000000, 0), legacy)
+
+	_, err = IssuedAt(jwt.MapClaims{})
+	assert.Error(t, err, "Token without iat should be rejected")
+}
+
+func TestJWKSHandler(t *testing.T) {
+	private, _ := newEd25519Files(t, "signing")
+	ks, err := LoadKeySet(KeySetOptions{PrivateKeyFile: private})
+	require.NoError(t, err)
+	SetKeySet(ks)
+	defer SetKeySet(NewHMACKeySet("test-secret"))
+
+	w := httptest.NewRecorder()
+	JWKSHandler(w, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+	var jwks JWKS
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&jwks))
+	require.Len(t, jwks.Keys, 1)
+	assert.Equal(t, ks.signing.ID, jwks.Keys[0].Kid)
+}
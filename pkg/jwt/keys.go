@@ -0,0 +1,302 @@
+package jwt
+
+import (
+	"cmp"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Key is one signing or verification key. Asymmetric keys are identified by
+// their RFC 7638 thumbprint, which is put into the kid header of tokens.
+type Key struct {
+	ID     string
+	Method jwt.SigningMethod
+	// 署名には private、検証には public を使う。HMAC では両方とも secret
+	private interface{}
+	public  interface{}
+}
+
+// KeySet signs tokens with one key and verifies them with every key it holds,
+// so keys can be rotated without invalidating outstanding tokens.
+type KeySet struct {
+	signing *Key
+	keys    map[string]*Key
+	// kid のないトークンを検証する HMAC の鍵
+	hmac *Key
+}
+
+// KeySetOptions are the files and secret LoadKeySet reads the keys from.
+type KeySetOptions struct {
+	// PrivateKeyFile is a PEM encoded RSA or Ed25519 private key to sign with.
+	PrivateKeyFile string
+	// PublicKeyFiles are PEM encoded public keys of previous signing keys that
+	// are still accepted.
+	PublicKeyFiles []string
+	// HMACSecret signs tokens when there is no private key. With a private key
+	// it only verifies tokens signed before switching; leave it empty to stop
+	// accepting them.
+	HMACSecret string
+}
+
+var ErrNoSigningKey = errors.New("either a private key or an HMAC secret is required")
+
+// LoadKeySet reads the keys of the options.
+func LoadKeySet(options KeySetOptions) (*KeySet, error) {
+	ks := &KeySet{keys: map[string]*Key{}}
+
+	if options.HMACSecret != "" {
+		ks.hmac = &Key{Method: jwt.SigningMethodHS256, private: []byte(options.HMACSecret), public: []byte(options.HMACSecret)}
+	}
+
+	if options.PrivateKeyFile != "" {
+		key, err := loadPrivateKey(options.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		ks.signing = key
+		ks.keys[key.ID] = key
+	} else if ks.hmac != nil {
+		ks.signing = ks.hmac
+	} else {
+		return nil, ErrNoSigningKey
+	}
+
+	for _, file := range options.PublicKeyFiles {
+		key, err := loadPublicKey(file)
+		if err != nil {
+			return nil, err
+		}
+		ks.keys[key.ID] = key
+	}
+
+	return ks, nil
+}
+
+// NewHMACKeySet returns a KeySet that signs and verifies with the secret only.
+func NewHMACKeySet(secret string) *KeySet {
+	key := &Key{Method: jwt.SigningMethodHS256, private: []byte(secret), public: []byte(secret)}
+	return &KeySet{signing: key, keys: map[string]*Key{}, hmac: key}
+}
+
+// Sign signs the claims with the signing key and sets its kid.
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(ks.signing.Method, claims)
+	if ks.signing.ID != "" {
+		token.Header["kid"] = ks.signing.ID
+	}
+	return token.SignedString(ks.signing.private)
+}
+
+// Parse verifies the token with the key of its kid, or with the HMAC secret if
+// it has none, and returns its claims.
+func (ks *KeySet) Parse(tokenStr string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(tokenStr, claims, func(token *jwt.Token) (interface{}, error) {
+		key, err := ks.verificationKey(token)
+		if err != nil {
+			return nil, err
+		}
+		// alg を書き換えて別の方式で検証させる攻撃を防ぐ
+		if token.Method.Alg() != key.Method.Alg() {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return key.public, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, jwt.ErrTokenSignatureInvalid
+	}
+	return claims, nil
+}
+
+func (ks *KeySet) verificationKey(token *jwt.Token) (*Key, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		if ks.hmac == nil {
+			return nil, errors.New("token has no kid")
+		}
+		return ks.hmac, nil
+	}
+	key, ok := ks.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown kid %q", kid)
+	}
+	return key, nil
+}
+
+// JWK is a public key in the JSON Web Key format.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Ed25519
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns every asymmetric verification key. HMAC secrets are never
+// published, so services that verify tokens need asymmetric keys.
+func (ks *KeySet) JWKS() JWKS {
+	jwks := JWKS{Keys: []JWK{}}
+	for _, key := range ks.keys {
+		jwk := publicJWK(key.public)
+		jwk.Kid = key.ID
+		jwk.Use = "sig"
+		jwk.Alg = key.Method.Alg()
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+	// 署名に使う鍵を先頭に、残りは kid 順に並べる
+	sortJWKs(jwks.Keys, ks.signing.ID)
+	return jwks
+}
+
+func loadPrivateKey(file string) (*Key, error) {
+	block, err := readPEM(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var private crypto.Signer
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		private, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		var parsed interface{}
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err == nil {
+			signer, ok := parsed.(crypto.Signer)
+			if !ok {
+				return nil, fmt.Errorf("unsupported private key in %s", file)
+			}
+			private = signer
+		}
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q in %s", block.Type, file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key %s: %w", file, err)
+	}
+
+	key, err := newAsymmetricKey(private.Public())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, file)
+	}
+	key.private = private
+	return key, nil
+}
+
+func loadPublicKey(file string) (*Key, error) {
+	block, err := readPEM(file)
+	if err != nil {
+		return nil, err
+	}
+	if block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("unsupported PEM block %q in %s", block.Type, file)
+	}
+	public, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key %s: %w", file, err)
+	}
+
+	key, err := newAsymmetricKey(public)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, file)
+	}
+	return key, nil
+}
+
+func readPEM(file string) (*pem.Block, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key: %w", err)
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in %s", file)
+	}
+	return block, nil
+}
+
+var errUnsupportedKey = errors.New("only RSA and Ed25519 keys are supported")
+
+func newAsymmetricKey(public crypto.PublicKey) (*Key, error) {
+	key := &Key{public: public}
+	switch public.(type) {
+	case *rsa.PublicKey:
+		key.Method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		key.Method = jwt.SigningMethodEdDSA
+	default:
+		return nil, errUnsupportedKey
+	}
+	key.ID = thumbprint(publicJWK(public))
+	return key, nil
+}
+
+func publicJWK(public interface{}) JWK {
+	switch k := public.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			Kty: "RSA",
+			N:   base64.RawURLEncoding.EncodeToString(k.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+		}
+	case ed25519.PublicKey:
+		return JWK{Kty: "OKP", Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(k)}
+	}
+	return JWK{}
+}
+
+// RFC 7638 の JWK thumbprint。必須のメンバーだけを辞書順に並べた JSON のハッシュ
+func thumbprint(jwk JWK) string {
+	var members interface{}
+	if jwk.Kty == "RSA" {
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.Kty, jwk.N}
+	} else {
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{jwk.Crv, jwk.Kty, jwk.X}
+	}
+	b, _ := json.Marshal(members)
+	sum := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func sortJWKs(keys []JWK, signingID string) {
+	rank := func(k JWK) string {
+		if k.Kid == signingID {
+			return ""
+		}
+		return k.Kid
+	}
+	slices.SortFunc(keys, func(a, b JWK) int { return cmp.Compare(rank(a), rank(b)) })
+}
@@ -0,0 +1,193 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeKeyPair(t *testing.T, name string, private interface{}, public interface{}) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	require.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(public)
+	require.NoError(t, err)
+
+	privateFile := filepath.Join(dir, name+".pem")
+	publicFile := filepath.Join(dir, name+".pub.pem")
+	require.NoError(t, os.WriteFile(privateFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}), 0o600))
+	require.NoError(t, os.WriteFile(publicFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}), 0o644))
+	return privateFile, publicFile
+}
+
+func newEd25519Files(t *testing.T, name string) (string, string) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return writeKeyPair(t, name, private, public)
+}
+
+func newRSAFiles(t *testing.T, name string) (string, string) {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return writeKeyPair(t, name, private, &private.PublicKey)
+}
+
+func testClaims() jwt.MapClaims {
+	return jwt.MapClaims{"userid": "user123", "exp": time.Now().Add(time.Minute).Unix()}
+}
+
+func TestKeySetSignAndParse(t *testing.T) {
+	ed25519Private, _ := newEd25519Files(t, "ed25519")
+	rsaPrivate, _ := newRSAFiles(t, "rsa")
+
+	testCases := []struct {
+		name        string
+		options     KeySetOptions
+		expectedAlg string
+		expectKid   bool
+	}{
+		{
+			name:        "Ed25519",
+			options:     KeySetOptions{PrivateKeyFile: ed25519Private},
+			expectedAlg: "EdDSA",
+			expectKid:   true,
+		},
+		{
+			name:        "RSA",
+			options:     KeySetOptions{PrivateKeyFile: rsaPrivate},
+			expectedAlg: "RS256",
+			expectKid:   true,
+		},
+		{
+			name:        "HMAC without private key",
+			options:     KeySetOptions{HMACSecret: "secret"},
+			expectedAlg: "HS256",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ks, err := LoadKeySet(tc.options)
+			require.NoError(t, err)
+
+			tokenStr, err := ks.Sign(testClaims())
+			require.NoError(t, err)
+
+			token, _, err := jwt.NewParser().ParseUnverified(tokenStr, jwt.MapClaims{})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAlg, token.Method.Alg(), "Algorithm mismatch")
+			_, hasKid := token.Header["kid"]
+			assert.Equal(t, tc.expectKid, hasKid, "kid header mismatch")
+
+			claims, err := ks.Parse(tokenStr)
+			require.NoError(t, err)
+			assert.Equal(t, "user123", claims["userid"])
+		})
+	}
+}
+
+func TestKeySetRotation(t *testing.T) {
+	oldPrivate, oldPublic := newEd25519Files(t, "old")
+	newPrivate, _ := newRSAFiles(t, "new")
+
+	oldKeys, err := LoadKeySet(KeySetOptions{PrivateKeyFile: oldPrivate, HMACSecret: "old-secret"})
+	require.NoError(t, err)
+	oldToken, err := oldKeys.Sign(testClaims())
+	require.NoError(t, err)
+	hmacToken, err := NewHMACKeySet("old-secret").Sign(testClaims())
+	require.NoError(t, err)
+
+	// 新しい鍵で署名し、古い公開鍵でも検証する
+	rotated, err := LoadKeySet(KeySetOptions{PrivateKeyFile: newPrivate, PublicKeyFiles: []string{oldPublic}})
+	require.NoError(t, err)
+	_, err = rotated.Parse(oldToken)
+	assert.NoError(t, err, "Token signed with the old key should be accepted")
+	_, err = rotated.Parse(hmacToken)
+	assert.Error(t, err, "HMAC token should be rejected without the secret")
+
+	withSecret, err := LoadKeySet(KeySetOptions{PrivateKeyFile: newPrivate, HMACSecret: "old-secret"})
+	require.NoError(t, err)
+	_, err = withSecret.Parse(hmacToken)
+	assert.NoError(t, err, "HMAC token should be accepted while the secret is kept")
+
+	// 古い公開鍵を外すと古いトークンは通らない
+	newOnly, err := LoadKeySet(KeySetOptions{PrivateKeyFile: newPrivate})
+	require.NoError(t, err)
+	_, err = newOnly.Parse(oldToken)
+	assert.Error(t, err, "Token of a removed key should be rejected")
+}
+
+func TestKeySetRejectsAlgorithmConfusion(t *testing.T) {
+	private, public := newRSAFiles(t, "rsa")
+	ks, err := LoadKeySet(KeySetOptions{PrivateKeyFile: private})
+	require.NoError(t, err)
+	kid := ks.signing.ID
+
+	// 公開鍵を HMAC の秘密として署名したトークン
+	publicPEM, err := os.ReadFile(public)
+	require.NoError(t, err)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaims())
+	token.Header["kid"] = kid
+	forged, err := token.SignedString(publicPEM)
+	require.NoError(t, err)
+
+	_, err = ks.Parse(forged)
+	assert.Error(t, err)
+}
+
+func TestJWKS(t *testing.T) {
+	newPrivate, _ := newEd25519Files(t, "new")
+	_, oldPublic := newRSAFiles(t, "old")
+
+	ks, err := LoadKeySet(KeySetOptions{PrivateKeyFile: newPrivate, PublicKeyFiles: []string{oldPublic}, HMACSecret: "secret"})
+	require.NoError(t, err)
+
+	jwks := ks.JWKS()
+	require.Len(t, jwks.Keys, 2, "HMAC secret should not be published")
+
+	assert.Equal(t, ks.signing.ID, jwks.Keys[0].Kid, "Signing key should come first")
+	assert.Equal(t, "OKP", jwks.Keys[0].Kty)
+	assert.Equal(t, "Ed25519", jwks.Keys[0].Crv)
+	assert.Equal(t, "EdDSA", jwks.Keys[0].Alg)
+	assert.NotEmpty(t, jwks.Keys[0].X)
+
+	assert.Equal(t, "RSA", jwks.Keys[1].Kty)
+	assert.Equal(t, "RS256", jwks.Keys[1].Alg)
+	assert.Equal(t, "AQAB", jwks.Keys[1].E)
+	assert.NotEmpty(t, jwks.Keys[1].N)
+}
+
+func TestThumbprint(t *testing.T) {
+	// RFC 7638 3.1 の例
+	jwk := JWK{
+		Kty: "RSA",
+		N:   "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw",
+		E:   "AQAB",
+	}
+	assert.Equal(t, "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs", thumbprint(jwk))
+}
+
+func TestLoadKeySetErrors(t *testing.T) {
+	_, err := LoadKeySet(KeySetOptions{})
+	assert.ErrorIs(t, err, ErrNoSigningKey)
+
+	_, err = LoadKeySet(KeySetOptions{PrivateKeyFile: filepath.Join(t.TempDir(), "missing.pem")})
+	assert.Error(t, err)
+
+	notPEM := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(notPEM, []byte("not a key"), 0o600))
+	_, err = LoadKeySet(KeySetOptions{PrivateKeyFile: notPEM})
+	assert.Error(t, err)
+}
@@ -0,0 +1,113 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer sends plain text mails. Handlers depend on this interface so tests and
+// local development do not need an SMTP server.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// SMTPMailer sends mails through an SMTP server. STARTTLS is used when the
+// server supports it.
+type SMTPMailer struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	var auth smtp.Auth
+	if m.Username != "" {
+		auth = smtp.PlainAuth("", m.Username, m.Password, m.Host)
+	}
+	addr := net.JoinHostPort(m.Host, strconv.Itoa(m.Port))
+	if err := smtp.SendMail(addr, auth, m.From, []string{msg.To}, Format(m.From, msg, time.Now())); err != nil {
+		return fmt.Errorf("failed to send mail via smtp: %w", err)
+	}
+	return nil
+}
+
+// MemoryMailer keeps every sent mail in memory.
+type MemoryMailer struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func (m *MemoryMailer) Send(ctx context.Context, msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+// Messages returns the sent mails in the order they were sent.
+func (m *MemoryMailer) Messages() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Message(nil), m.messages...)
+}
+
+// FileMailer writes every mail as an .eml file into Dir, so mails sent in local
+// development can be opened with a mail client.
+type FileMailer struct {
+	Dir  string
+	From string
+}
+
+var unsafeFileNameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+func (m *FileMailer) Send(ctx context.Context, msg Message) error {
+	if err := os.MkdirAll(m.Dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create outbox: %w", err)
+	}
+
+	now := time.Now()
+	name := now.Format("20060102T150405.000000000") + "_" + unsafeFileNameChars.ReplaceAllString(msg.To, "_") + ".eml"
+	if err := os.WriteFile(filepath.Join(m.Dir, name), Format(m.From, msg, now), 0o644); err != nil {
+		return fmt.Errorf("failed to write mail: %w", err)
+	}
+	return nil
+}
+
+// Format builds an RFC 5322 message. The subject is MIME encoded so it can
+// contain non-ASCII characters.
+func Format(from string, msg Message, date time.Time) []byte {
+	var sb strings.Builder
+	sb.WriteString("From: " + stripNewlines(from) + "\r\n")
+	sb.WriteString("To: " + stripNewlines(msg.To) + "\r\n")
+	sb.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", stripNewlines(msg.Subject)) + "\r\n")
+	sb.WriteString("Date: " + date.Format(time.RFC1123Z) + "\r\n")
+	sb.WriteString("MIME-Version: 1.0\r\n")
+	sb.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	sb.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	sb.WriteString("\r\n")
+	sb.WriteString(strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n"))
+	return []byte(sb.String())
+}
+
+// ヘッダーインジェクションを防ぐ
+func stripNewlines(s string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(s)
+}
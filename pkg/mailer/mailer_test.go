@@ -0,0 +1,92 @@
+package mailer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) {
+	date := time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		msg      Message
+		contains []string
+		excludes []string
+	}{
+		{
+			name: "Headers and body",
+			msg:  Message{To: "yamada@example.com", Subject: "Verify your email", Body: "line1\nline2"},
+			contains: []string{
+				"From: noreply@example.com\r\n",
+				"To: yamada@example.com\r\n",
+				"Subject: Verify your email\r\n",
+				"Date: Mon, 01 Apr 2024 12:00:00 +0000\r\n",
+				"Content-Type: text/plain; charset=UTF-8\r\n",
+				"\r\n\r\nline1\r\nline2",
+			},
+		},
+		{
+			name:     "Non-ASCII subject is encoded",
+			msg:      Message{To: "yamada@example.com", Subject: "パスワードの再設定"},
+			contains: []string{"Subject: =?utf-8?q?"},
+			excludes: []string{"パスワード"},
+		},
+		{
+			name:     "Newlines in headers are removed",
+			msg:      Message{To: "yamada@example.com\r\nBcc: evil@example.com", Subject: "hi"},
+			contains: []string{"To: yamada@example.comBcc: evil@example.com\r\n"},
+			excludes: []string{"\r\nBcc:"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			formatted := string(Format("noreply@example.com", tc.msg, date))
+
+			for _, s := range tc.contains {
+				assert.Contains(t, formatted, s)
+			}
+			for _, s := range tc.excludes {
+				assert.NotContains(t, formatted, s)
+			}
+		})
+	}
+}
+
+func TestMemoryMailer(t *testing.T) {
+	m := &MemoryMailer{}
+
+	require.NoError(t, m.Send(context.Background(), Message{To: "a@example.com", Subject: "first"}))
+	require.NoError(t, m.Send(context.Background(), Message{To: "b@example.com", Subject: "second"}))
+
+	messages := m.Messages()
+	require.Len(t, messages, 2)
+	assert.Equal(t, "first", messages[0].Subject)
+	assert.Equal(t, "second", messages[1].Subject)
+}
+
+func TestFileMailer(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "outbox")
+	m := &FileMailer{Dir: dir, From: "noreply@example.com"}
+
+	err := m.Send(context.Background(), Message{To: "yamada@example.com", Subject: "hi", Body: "hello"})
+	require.NoError(t, err)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.True(t, strings.HasSuffix(entries[0].Name(), "_yamada_example.com.eml"), "File name mismatch: %s", entries[0].Name())
+
+	body, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "To: yamada@example.com\r\n")
+	assert.True(t, strings.HasSuffix(string(body), "\r\n\r\nhello"))
+}
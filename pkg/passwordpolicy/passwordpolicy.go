@@ -0,0 +1,60 @@
+// Package passwordpolicy checks new passwords against the policy of the
+// service: a minimum length, the 72 byte limit of bcrypt, and a local list of
+// common passwords known from breaches.
+package passwordpolicy
+
+import (
+	_ "embed"
+	"errors"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	MinLength = 8
+	// bcrypt は 72 バイトより後ろを使わない
+	MaxBytes = 72
+)
+
+var (
+	ErrTooShort = errors.New("password is too short")
+	ErrTooLong  = errors.New("password is too long")
+	ErrCommon   = errors.New("password is too common")
+)
+
+//go:embed common_passwords.txt
+var commonPasswordList string
+
+var commonPasswords = sync.OnceValue(func() map[string]struct{} {
+	passwords := make(map[string]struct{})
+	for _, line := range strings.Split(commonPasswordList, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		passwords[line] = struct{}{}
+	}
+	return passwords
+})
+
+// Check returns the reason the password cannot be used, or nil.
+func Check(password string) error {
+	if utf8.RuneCountInString(password) < MinLength {
+		return ErrTooShort
+	}
+	if len(password) > MaxBytes {
+		return ErrTooLong
+	}
+	if IsCommon(password) {
+		return ErrCommon
+	}
+	return nil
+}
+
+// IsCommon reports whether the password is on the list of common passwords,
+// ignoring case.
+func IsCommon(password string) bool {
+	_, ok := commonPasswords()[strings.ToLower(password)]
+	return ok
+}
@@ -0,0 +1,40 @@
+package passwordpolicy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck(t *testing.T) {
+	testCases := []struct {
+		name     string
+		password string
+		expected error
+	}{
+		{name: "Strong password", password: "correct-horse-battery"},
+		{name: "Exactly the minimum length", password: "k7#vQm2x"},
+		{name: "Too short", password: "k7#vQm2", expected: ErrTooShort},
+		// 文字数で数えるので日本語 8 文字は通る
+		{name: "Multibyte characters", password: "単語を覚えるための鍵", expected: nil},
+		{name: "Exactly the maximum bytes", password: strings.Repeat("a1", MaxBytes/2)},
+		{name: "Too long", password: strings.Repeat("a", MaxBytes+1), expected: ErrTooLong},
+		{name: "Common password", password: "password123", expected: ErrCommon},
+		{name: "Common password in upper case", password: "QWERTYUIOP", expected: ErrCommon},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Check(tc.password))
+		})
+	}
+}
+
+func TestCommonPasswordList(t *testing.T) {
+	for password := range commonPasswords() {
+		assert.Equal(t, strings.ToLower(password), password, "Entries should be in lower case")
+		assert.False(t, strings.HasPrefix(password, "#"), "Comments should be skipped")
+	}
+	assert.NotEmpty(t, commonPasswords())
+}
@@ -0,0 +1,154 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// State is what a Store keeps for each key.
+type State struct {
+	Failures     int
+	LastFailedAt time.Time
+	LockedUntil  time.Time
+}
+
+// Store keeps the failure state of every key. Use MemoryStore for a single
+// instance and a shared store such as MySQL when several instances serve the
+// same users.
+type Store interface {
+	Get(ctx context.Context, key string) (State, error)
+	// Update replaces the state of the key with the result of fn atomically.
+	Update(ctx context.Context, key string, fn func(State) State) (State, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// Policy decides how long a key is locked after failures. The first
+// FreeAttempts failures are not locked, then the lock doubles from BaseDelay
+// up to MaxDelay. Failures are forgotten ResetAfter the last one.
+type Policy struct {
+	FreeAttempts int
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+	ResetAfter   time.Duration
+}
+
+// Limiter locks keys that failed too many times, e.g. the email or the IP
+// address of login attempts.
+type Limiter struct {
+	Store  Store
+	Prefix string
+	Policy Policy
+	Now    func() time.Time
+}
+
+func NewLimiter(store Store, prefix string, policy Policy) *Limiter {
+	return &Limiter{Store: store, Prefix: prefix, Policy: policy, Now: time.Now}
+}
+
+// Allow returns how long the key has to wait before the next attempt, or zero
+// if it is not locked.
+func (l *Limiter) Allow(ctx context.Context, key string) (time.Duration, error) {
+	state, err := l.Store.Get(ctx, l.Prefix+key)
+	if err != nil {
+		return 0, err
+	}
+	return retryAfter(state, l.Now()), nil
+}
+
+// Fail records a failure of the key and returns how long it is locked.
+func (l *Limiter) Fail(ctx context.Context, key string) (time.Duration, error) {
+	now := l.Now()
+	state, err := l.Store.Update(ctx, l.Prefix+key, func(state State) State {
+		if now.Sub(state.LastFailedAt) > l.Policy.ResetAfter {
+			state = State{}
+		}
+		state.Failures++
+		state.LastFailedAt = now
+		if over := state.Failures - l.Policy.FreeAttempts; over > 0 {
+			state.LockedUntil = now.Add(l.delay(over))
+		}
+		return state
+	})
+	if err != nil {
+		return 0, err
+	}
+	return retryAfter(state, now), nil
+}
+
+// Reset forgets the failures of the key, e.g. after a successful login.
+func (l *Limiter) Reset(ctx context.Context, key string) error {
+	return l.Store.Delete(ctx, l.Prefix+key)
+}
+
+// over 回目の超過で BaseDelay * 2^(over-1)
+func (l *Limiter) delay(over int) time.Duration {
+	delay := l.Policy.BaseDelay
+	for i := 1; i < over; i++ {
+		delay *= 2
+		if delay >= l.Policy.MaxDelay {
+			return l.Policy.MaxDelay
+		}
+	}
+	return min(delay, l.Policy.MaxDelay)
+}
+
+func retryAfter(state State, now time.Time) time.Duration {
+	if now.Before(state.LockedUntil) {
+		return state.LockedUntil.Sub(now)
+	}
+	return 0
+}
+
+// MemoryStore keeps the states in memory. States are lost on restart and not
+// shared between instances.
+type MemoryStore struct {
+	mu      sync.Mutex
+	states  map[string]State
+	updates int
+}
+
+// 古い状態を掃除する間隔と、掃除の対象になるまでの時間
+const (
+	memoryStoreSweepInterval = 1024
+	memoryStoreStateTTL      = 24 * time.Hour
+)
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{states: map[string]State{}}
+}
+
+func (s *MemoryStore) Get(ctx context.Context, key string) (State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.states[key], nil
+}
+
+func (s *MemoryStore) Update(ctx context.Context, key string, fn func(State) State) (State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := fn(s.states[key])
+	s.states[key] = state
+
+	s.updates++
+	if s.updates%memoryStoreSweepInterval == 0 {
+		s.sweep(time.Now())
+	}
+	return state, nil
+}
+
+func (s *MemoryStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.states, key)
+	return nil
+}
+
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, state := range s.states {
+		if now.Sub(state.LastFailedAt) > memoryStoreStateTTL && !now.Before(state.LockedUntil) {
+			delete(s.states, key)
+		}
+	}
+}
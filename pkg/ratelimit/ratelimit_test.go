@@ -0,0 +1,101 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPolicy = Policy{
+	FreeAttempts: 3,
+	BaseDelay:    time.Second,
+	MaxDelay:     5 * time.Second,
+	ResetAfter:   time.Minute,
+}
+
+func newTestLimiter(now *time.Time) *Limiter {
+	l := NewLimiter(NewMemoryStore(), "test:", testPolicy)
+	l.Now = func() time.Time { return *now }
+	return l
+}
+
+func TestLimiterFail(t *testing.T) {
+	now := time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)
+	l := newTestLimiter(&now)
+	ctx := context.Background()
+
+	// 3 回までは待たない、その後は 1, 2, 4, 5 (上限) 秒
+	expected := []time.Duration{0, 0, 0, time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, want := range expected {
+		got, err := l.Fail(ctx, "yamada@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, want, got, "Lock after failure %d", i+1)
+	}
+
+	retryAfter, err := l.Allow(ctx, "yamada@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, retryAfter)
+
+	retryAfter, err = l.Allow(ctx, "sato@example.com")
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter, "Other keys should not be locked")
+
+	now = now.Add(5 * time.Second)
+	retryAfter, err = l.Allow(ctx, "yamada@example.com")
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter, "Lock should expire")
+}
+
+func TestLimiterResetAfter(t *testing.T) {
+	now := time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)
+	l := newTestLimiter(&now)
+	ctx := context.Background()
+
+	for range 3 {
+		_, err := l.Fail(ctx, "key")
+		require.NoError(t, err)
+	}
+
+	// しばらく失敗しなければ数え直す
+	now = now.Add(2 * time.Minute)
+	retryAfter, err := l.Fail(ctx, "key")
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter, "Old failures should be forgotten")
+}
+
+func TestLimiterReset(t *testing.T) {
+	now := time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)
+	l := newTestLimiter(&now)
+	ctx := context.Background()
+
+	for range 4 {
+		_, err := l.Fail(ctx, "key")
+		require.NoError(t, err)
+	}
+	require.NoError(t, l.Reset(ctx, "key"))
+
+	retryAfter, err := l.Allow(ctx, "key")
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter)
+
+	retryAfter, err = l.Fail(ctx, "key")
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter, "Failures should be counted from zero")
+}
+
+func TestMemoryStoreSweep(t *testing.T) {
+	now := time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)
+	s := NewMemoryStore()
+	s.states["old"] = State{Failures: 1, LastFailedAt: now.Add(-25 * time.Hour)}
+	s.states["locked"] = State{Failures: 9, LastFailedAt: now.Add(-25 * time.Hour), LockedUntil: now.Add(time.Hour)}
+	s.states["new"] = State{Failures: 1, LastFailedAt: now.Add(-time.Hour)}
+
+	s.sweep(now)
+
+	assert.NotContains(t, s.states, "old")
+	assert.Contains(t, s.states, "locked")
+	assert.Contains(t, s.states, "new")
+}
@@ -0,0 +1,123 @@
+package snippet
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+const (
+	markOpen  = "<mark>"
+	markClose = "</mark>"
+	ellipsis  = "…"
+)
+
+// Highlight wraps every case-insensitive occurrence of the words in text with
+// <mark> tags. The rest of the text is HTML escaped so the result can be
+// rendered as is. It reports whether any word was found.
+func Highlight(text string, words []string) (string, bool) {
+	runes := []rune(text)
+	matches := findMatches(runes, words)
+	if len(matches) == 0 {
+		return "", false
+	}
+	return render(runes, matches, 0, len(runes)), true
+}
+
+// Excerpt is like Highlight but returns at most width characters of text
+// around the first occurrence, with an ellipsis where text was cut.
+func Excerpt(text string, words []string, width int) (string, bool) {
+	runes := []rune(text)
+	matches := findMatches(runes, words)
+	if len(matches) == 0 {
+		return "", false
+	}
+
+	// 最初に一致した位置が真ん中あたりに来るように切り出す
+	first := matches[0]
+	start := max(0, first[0]-max(0, width-(first[1]-first[0]))/2)
+	end := min(len(runes), start+width)
+	start = max(0, end-width)
+
+	var sb strings.Builder
+	if start > 0 {
+		sb.WriteString(ellipsis)
+	}
+	sb.WriteString(render(runes, matches, start, end))
+	if end < len(runes) {
+		sb.WriteString(ellipsis)
+	}
+	return sb.String(), true
+}
+
+// findMatches returns the [start, end) rune ranges of the words in runes,
+// left to right and without overlaps. The longest word wins at a position.
+func findMatches(runes []rune, words []string) [][2]int {
+	var lowerWords [][]rune
+	for _, word := range words {
+		if word == "" {
+			continue
+		}
+		lowerWords = append(lowerWords, toLower([]rune(word)))
+	}
+	if len(lowerWords) == 0 {
+		return nil
+	}
+
+	lowerRunes := toLower(runes)
+	var matches [][2]int
+	for i := 0; i < len(lowerRunes); {
+		longest := 0
+		for _, word := range lowerWords {
+			if len(word) > longest && hasPrefix(lowerRunes[i:], word) {
+				longest = len(word)
+			}
+		}
+		if longest == 0 {
+			i++
+			continue
+		}
+		matches = append(matches, [2]int{i, i + longest})
+		i += longest
+	}
+	return matches
+}
+
+// render escapes runes[start:end] and marks the matches inside of it.
+func render(runes []rune, matches [][2]int, start, end int) string {
+	var sb strings.Builder
+	pos := start
+	for _, m := range matches {
+		matchStart, matchEnd := max(m[0], start), min(m[1], end)
+		if matchStart >= matchEnd {
+			continue
+		}
+		sb.WriteString(html.EscapeString(string(runes[pos:matchStart])))
+		sb.WriteString(markOpen)
+		sb.WriteString(html.EscapeString(string(runes[matchStart:matchEnd])))
+		sb.WriteString(markClose)
+		pos = matchEnd
+	}
+	sb.WriteString(html.EscapeString(string(runes[pos:end])))
+	return sb.String()
+}
+
+func toLower(runes []rune) []rune {
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+	return lower
+}
+
+func hasPrefix(runes, prefix []rune) bool {
+	if len(runes) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if runes[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
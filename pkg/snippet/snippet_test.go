@@ -0,0 +1,121 @@
+package snippet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHighlight(t *testing.T) {
+	testCases := []struct {
+		name      string
+		text      string
+		words     []string
+		expected  string
+		wantFound bool
+	}{
+		{
+			name:      "Case insensitive match",
+			text:      "Structured Query Language",
+			words:     []string{"query"},
+			expected:  "Structured <mark>Query</mark> Language",
+			wantFound: true,
+		},
+		{
+			name:      "Japanese words",
+			text:      "リレーショナルデータベースの操作に使用される言語。",
+			words:     []string{"データベース", "言語"},
+			expected:  "リレーショナル<mark>データベース</mark>の操作に使用される<mark>言語</mark>。",
+			wantFound: true,
+		},
+		{
+			name:      "Text is HTML escaped",
+			text:      "<b>SQL</b> & more",
+			words:     []string{"sql"},
+			expected:  "&lt;b&gt;<mark>SQL</mark>&lt;/b&gt; &amp; more",
+			wantFound: true,
+		},
+		{
+			name:      "Longest word wins",
+			text:      "TCP/IP",
+			words:     []string{"TCP", "TCP/IP"},
+			expected:  "<mark>TCP/IP</mark>",
+			wantFound: true,
+		},
+		{
+			name:      "No match",
+			text:      "Docker",
+			words:     []string{"kubernetes", ""},
+			expected:  "",
+			wantFound: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, found := Highlight(tc.text, tc.words)
+			assert.Equal(t, tc.wantFound, found)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestExcerpt(t *testing.T) {
+	testCases := []struct {
+		name      string
+		text      string
+		words     []string
+		width     int
+		expected  string
+		wantFound bool
+	}{
+		{
+			name:      "Short text is not cut",
+			text:      "コンテナ型の仮想化技術。",
+			words:     []string{"仮想化"},
+			width:     20,
+			expected:  "コンテナ型の<mark>仮想化</mark>技術。",
+			wantFound: true,
+		},
+		{
+			name:      "Cut around the match",
+			text:      "0123456789abcdefghij0123456789",
+			words:     []string{"fgh"},
+			width:     10,
+			expected:  "…cde<mark>fgh</mark>ij01…",
+			wantFound: true,
+		},
+		{
+			name:      "Match at the start",
+			text:      "abcdefghij",
+			words:     []string{"ab"},
+			width:     4,
+			expected:  "<mark>ab</mark>cd…",
+			wantFound: true,
+		},
+		{
+			name:      "Match cut at the end of the window",
+			text:      "abcdefghij",
+			words:     []string{"hij"},
+			width:     2,
+			expected:  "…<mark>hi</mark>…",
+			wantFound: true,
+		},
+		{
+			name:      "No match",
+			text:      "abcdefghij",
+			words:     []string{"xyz"},
+			width:     4,
+			expected:  "",
+			wantFound: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, found := Excerpt(tc.text, tc.words, tc.width)
+			assert.Equal(t, tc.wantFound, found)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
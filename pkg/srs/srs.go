@@ -0,0 +1,111 @@
+// Package srs schedules reviews of flash cards with the SM-2 algorithm of
+// SuperMemo. It knows nothing about storage; callers keep the Card of each
+// item and pass it back on the next review. The clock is a field so that
+// schedules can be tested deterministically.
+package srs
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// Grade is how well the card was recalled.
+type Grade string
+
+const (
+	// GradeAgain means the card was forgotten and is learned again from the start.
+	GradeAgain Grade = "again"
+	GradeHard  Grade = "hard"
+	GradeGood  Grade = "good"
+	GradeEasy  Grade = "easy"
+)
+
+const (
+	InitialEase = 2.5
+	MinEase     = 1.3
+	// 2 回目に正解したときの間隔 (SM-2 の I(2))
+	secondInterval = 6
+	day            = 24 * time.Hour
+)
+
+var ErrInvalidGrade = errors.New("invalid grade")
+
+// SM-2 の回答の質 q (0-5)。3 未満は忘れたものとして扱う
+var gradeQualities = map[Grade]int{
+	GradeAgain: 1,
+	GradeHard:  3,
+	GradeGood:  4,
+	GradeEasy:  5,
+}
+
+// Card is the review state of one item.
+type Card struct {
+	Ease float64
+	// 次の復習までの日数
+	IntervalDays int
+	// 忘れずに続けて正解した回数
+	Repetitions int
+	Lapses      int
+	Due         time.Time
+	// 一度も復習していなければ nil
+	LastReviewedAt *time.Time
+}
+
+type Scheduler struct {
+	Now func() time.Time
+}
+
+func NewScheduler() *Scheduler {
+	return &Scheduler{Now: time.Now}
+}
+
+// NewCard returns the state of an item that has never been reviewed. It is
+// due at once.
+func (s *Scheduler) NewCard() Card {
+	return Card{Ease: InitialEase, Due: s.Now()}
+}
+
+// Review returns the state of the card after it was recalled with the grade
+// now. The card itself is not changed.
+func (s *Scheduler) Review(card Card, grade Grade) (Card, error) {
+	q, ok := gradeQualities[grade]
+	if !ok {
+		return card, ErrInvalidGrade
+	}
+	now := s.Now()
+
+	if q < 3 {
+		card.Repetitions = 0
+		card.IntervalDays = 1
+		card.Lapses++
+	} else {
+		switch card.Repetitions {
+		case 0:
+			card.IntervalDays = 1
+		case 1:
+			card.IntervalDays = secondInterval
+		default:
+			card.IntervalDays = int(math.Round(float64(card.IntervalDays) * card.Ease))
+		}
+		card.Repetitions++
+	}
+
+	// EF' = EF + (0.1 - (5 - q) * (0.08 + (5 - q) * 0.02))
+	d := float64(5 - q)
+	card.Ease = max(MinEase, roundEase(card.Ease+0.1-d*(0.08+d*0.02)))
+
+	card.Due = now.Add(time.Duration(card.IntervalDays) * day)
+	card.LastReviewedAt = &now
+	return card, nil
+}
+
+// IsDue reports whether the card should be reviewed now.
+func (s *Scheduler) IsDue(card Card) bool {
+	return !card.Due.After(s.Now())
+}
+
+// 保存したときに値が変わらないように小数第 2 位に丸める
+func roundEase(ease float64) float64 {
+	return math.Round(ease*100) / 100
+}
@@ -0,0 +1,89 @@
+package srs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestScheduler(now *time.Time) *Scheduler {
+	s := NewScheduler()
+	s.Now = func() time.Time { return *now }
+	return s
+}
+
+func TestReview(t *testing.T) {
+	now := time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC)
+	s := newTestScheduler(&now)
+
+	card := s.NewCard()
+	assert.True(t, s.IsDue(card), "New card should be due at once")
+
+	// 1 日、6 日のあとは更新前の EF を掛けて間隔が伸びる
+	steps := []struct {
+		grade            Grade
+		wantIntervalDays int
+		wantEase         float64
+	}{
+		{GradeGood, 1, 2.5},
+		{GradeGood, 6, 2.5},
+		{GradeGood, 15, 2.5},
+		{GradeEasy, 38, 2.6},
+		{GradeHard, 99, 2.46},
+	}
+	for i, step := range steps {
+		var err error
+		card, err = s.Review(card, step.grade)
+		require.NoError(t, err)
+		assert.Equal(t, step.wantIntervalDays, card.IntervalDays, "Interval of step %d", i)
+		assert.Equal(t, step.wantEase, card.Ease, "Ease of step %d", i)
+		assert.Equal(t, i+1, card.Repetitions, "Repetitions of step %d", i)
+		assert.Equal(t, now.AddDate(0, 0, step.wantIntervalDays), card.Due, "Due of step %d", i)
+		require.NotNil(t, card.LastReviewedAt)
+		assert.Equal(t, now, *card.LastReviewedAt)
+
+		assert.False(t, s.IsDue(card), "Card should not be due right after a review")
+		now = card.Due
+		assert.True(t, s.IsDue(card), "Card should be due on the due date")
+	}
+}
+
+func TestReviewAgain(t *testing.T) {
+	now := time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC)
+	s := newTestScheduler(&now)
+
+	card := Card{Ease: 2.5, IntervalDays: 15, Repetitions: 3, Due: now}
+	card, err := s.Review(card, GradeAgain)
+	require.NoError(t, err)
+	assert.Equal(t, 1, card.IntervalDays, "Forgotten card should be learned again")
+	assert.Equal(t, 0, card.Repetitions)
+	assert.Equal(t, 1, card.Lapses)
+	assert.Equal(t, 1.96, card.Ease)
+	assert.Equal(t, now.AddDate(0, 0, 1), card.Due)
+}
+
+func TestReviewMinEase(t *testing.T) {
+	now := time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC)
+	s := newTestScheduler(&now)
+
+	card := s.NewCard()
+	for range 10 {
+		var err error
+		card, err = s.Review(card, GradeAgain)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, MinEase, card.Ease)
+	assert.Equal(t, 10, card.Lapses)
+}
+
+func TestReviewInvalidGrade(t *testing.T) {
+	now := time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC)
+	s := newTestScheduler(&now)
+
+	card := s.NewCard()
+	reviewed, err := s.Review(card, "perfect")
+	assert.ErrorIs(t, err, ErrInvalidGrade)
+	assert.Equal(t, card, reviewed, "Card should not change on an invalid grade")
+}
@@ -3,14 +3,20 @@ package tester
 import (
 	"context"
 	"database/sql"
+	_ "embed"
 	"fmt"
-	"path/filepath"
 
 	"github.com/docker/go-connections/nat"
+	"github.com/takuchi17/term-keeper/app/migrations"
 	testcontainers "github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
 )
 
+// テスト用のデータ。テーブルは本番と同じマイグレーションで作成する
+//
+//go:embed testdata/seed.sql
+var seed string
+
 type MysqlContainer struct {
 	testcontainers.Container
 }
@@ -44,11 +50,6 @@ func (s *MysqlContainer) OpenDB(ctx context.Context) (*sql.DB, error) {
 }
 
 func SetupMySQL(ctx context.Context) (*MysqlContainer, error) {
-	initdbDir, err := filepath.Abs("../../pkg/tester/testdata")
-	if err != nil {
-		return nil, err
-	}
-
 	req := testcontainers.ContainerRequest{
 		Image: "mysql:8.0",
 		Env: map[string]string{
@@ -61,9 +62,6 @@ func SetupMySQL(ctx context.Context) (*MysqlContainer, error) {
 			"MYSQL_COLLATION_SERVER":     "utf8mb4_unicode_ci",
 		},
 		ExposedPorts: []string{"3306/tcp"},
-		Mounts: testcontainers.ContainerMounts{
-			testcontainers.BindMount(initdbDir, "/docker-entrypoint-initdb.d"),
-		},
 		WaitingFor: wait.ForSQL("3306", "mysql", func(host string, port nat.Port) string {
 			return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
 				"user",
@@ -82,7 +80,27 @@ func SetupMySQL(ctx context.Context) (*MysqlContainer, error) {
 		return nil, err
 	}
 
-	return &MysqlContainer{
+	mysqlContainer := &MysqlContainer{
 		Container: container,
-	}, nil
+	}
+	if err := mysqlContainer.migrateAndSeed(ctx); err != nil {
+		container.Terminate(ctx)
+		return nil, err
+	}
+
+	return mysqlContainer, nil
+}
+
+func (s *MysqlContainer) migrateAndSeed(ctx context.Context) error {
+	db, err := s.OpenDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if _, err := migrations.Up(db); err != nil {
+		return err
+	}
+
+	return migrations.ExecScript(db, seed)
 }
@@ -1,54 +1,11 @@
-CREATE DATABASE IF NOT EXISTS term_keeper_db_test;
-SET NAMES utf8mb4;
-USE term_keeper_db_test;
-
--- テーブル作成
-CREATE TABLE IF NOT EXISTS users (
-      id CHAR(26) NOT NULL,
-      name VARCHAR(32) NOT NULL,
-      email VARCHAR(255) NOT NULL UNIQUE,
-      password VARCHAR(255) NOT NULL,
-      created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
-      updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-      PRIMARY KEY(id)
-);
-
-CREATE TABLE IF NOT EXISTS categories (
-      id CHAR(26) NOT NULL,
-      fk_user_id CHAR(26) NOT NULL,
-      name VARCHAR(100) NOT NULL,
-      hex_color_code CHAR(7),
-      created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
-      updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-      FOREIGN KEY (fk_user_id) REFERENCES users(id) ON DELETE CASCADE,
-      PRIMARY KEY(id)
-);
-
-CREATE TABLE IF NOT EXISTS terms (
-      id CHAR(26) NOT NULL,
-      fk_user_id CHAR(26) NOT NULL,
-      name VARCHAR(255) NOT NULL,
-      description VARCHAR(500),
-      created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
-      updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-      FOREIGN KEY (fk_user_id) REFERENCES users(id) ON DELETE CASCADE,
-      PRIMARY KEY(id)
-);
-
-CREATE TABLE IF NOT EXISTS term_category_relations (
-      fk_term_id CHAR(26) NOT NULL,
-      fk_category_id CHAR(26) NOT NULL,
-      FOREIGN KEY (fk_term_id) REFERENCES terms(id) ON DELETE CASCADE,
-      FOREIGN KEY (fk_category_id) REFERENCES categories(id) ON DELETE CASCADE,
-      PRIMARY KEY(fk_term_id, fk_category_id)
-);
+-- テーブルは app/migrations のマイグレーションで作成する
 -- テストデータの挿入
 
--- ユーザーデータ挿入
-INSERT INTO users (id, name, email, password) VALUES
-('01HGDJ5GZRJ2J5VEXR8HT8V9WF', '山田太郎', 'yamada@example.com', '$2a$10$abcdefghijklmnopqrstuv'),
-('01HGDJ5HXZD3K6WFYS9JU0A1XG', '佐藤花子', 'sato@example.com', '$2a$10$wxyzabcdefghijklmnopqr'),
-('01HGDJ5J8KF4L7XGZT0KV1B2YH', '鈴木一郎', 'suzuki@example.com', '$2a$10$rstuvwxyzabcdefghijklm');
+-- ユーザーデータ挿入 (鈴木はメール未確認)
+INSERT INTO users (id, name, email, password, email_verified_at) VALUES
+('01HGDJ5GZRJ2J5VEXR8HT8V9WF', '山田太郎', 'yamada@example.com', '$2a$10$abcdefghijklmnopqrstuv', '2024-01-01 00:00:00'),
+('01HGDJ5HXZD3K6WFYS9JU0A1XG', '佐藤花子', 'sato@example.com', '$2a$10$wxyzabcdefghijklmnopqr', '2024-01-01 00:00:00'),
+('01HGDJ5J8KF4L7XGZT0KV1B2YH', '鈴木一郎', 'suzuki@example.com', '$2a$10$rstuvwxyzabcdefghijklm', NULL);
 
 -- カテゴリーデータ挿入（ID付き）
 INSERT INTO categories (id, fk_user_id, name, hex_color_code) VALUES
@@ -72,6 +29,11 @@ INSERT INTO terms (id, fk_user_id, name, description) VALUES
 ('TERM009NOSQL0000000000001', '01HGDJ5J8KF4L7XGZT0KV1B2YH', 'NoSQL', '非リレーショナルデータベース。'),
 ('TERM010CICD00000000000001', '01HGDJ5J8KF4L7XGZT0KV1B2YH', 'CI/CD', '継続的インテグレーション/継続的デリバリー。');
 
+-- 用語の理解度（指定のない用語は unread）
+UPDATE terms SET status = 'understood', status_changed_at = '2024-01-10 10:00:00' WHERE id = 'TERM001SQL000000000000001';
+UPDATE terms SET status = 'researching', status_changed_at = '2024-01-11 10:00:00' WHERE id = 'TERM002TCP000000000000001';
+UPDATE terms SET status = 'mastered', status_changed_at = '2024-01-12 10:00:00' WHERE id = 'TERM003DOCK00000000000001';
+
 -- カテゴリーと用語の関連付け（IDに一致させる）
 INSERT INTO term_category_relations (fk_term_id, fk_category_id) VALUES
 ('TERM001SQL000000000000001', 'CATE002DBS0000000000000001'), -- SQL → データベース
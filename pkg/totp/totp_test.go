@@ -0,0 +1,98 @@
+package totp
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// RFC 6238 の付録 B の鍵 "12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	// RFC 6238 の SHA-1 の 8 桁の値の下 6 桁
+	testCases := []struct {
+		unix     int64
+		expected string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tc := range testCases {
+		code, err := Code(rfcSecret, time.Unix(tc.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, code, "Code at %d", tc.unix)
+	}
+
+	_, err := Code("not base32!", time.Now())
+	assert.Error(t, err)
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := Step(now)
+	code, err := Code(rfcSecret, now)
+	require.NoError(t, err)
+	previous, err := Code(rfcSecret, now.Add(-Period))
+	require.NoError(t, err)
+	tooOld, err := Code(rfcSecret, now.Add(-2*Period))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		code         string
+		lastStep     int64
+		expectedStep int64
+		expectedOK   bool
+	}{
+		{name: "Current code", code: code, expectedStep: current, expectedOK: true},
+		{name: "Previous code within skew", code: previous, expectedStep: current - 1, expectedOK: true},
+		{name: "Code outside skew", code: tooOld},
+		{name: "Replayed code", code: code, lastStep: current},
+		{name: "Wrong code", code: "000000"},
+		{name: "Wrong length", code: "12345"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			step, ok := Verify(rfcSecret, tc.code, now, tc.lastStep)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expectedStep, step)
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	assert.Len(t, secret, 32)
+
+	other, err := GenerateSecret()
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, other)
+
+	_, err = Code(secret, time.Now())
+	assert.NoError(t, err, "Generated secret should be usable")
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("Term Keeper", "yamada@example.com", rfcSecret)
+
+	u, err := url.Parse(uri)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/Term Keeper:yamada@example.com", u.Path)
+	assert.Equal(t, rfcSecret, u.Query().Get("secret"))
+	assert.Equal(t, "Term Keeper", u.Query().Get("issuer"))
+	assert.Equal(t, "6", u.Query().Get("digits"))
+	assert.Equal(t, "30", u.Query().Get("period"))
+}